@@ -0,0 +1,111 @@
+package quamina
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+)
+
+// A pattern can declare that an object is "closed" by including the member "$closed": true, which means
+// that the pattern only matches if the event object at that path has no members other than those the pattern
+// mentions. For example, {"detail": {"$closed": true, "a": [1], "b": [{"exists": true}]}} does not match
+// {"detail": {"a": 1, "b": 2, "c": 3}}. A "$closed" member whose value isn't true or false is an ordinary
+// member, so events with members named "$closed" can still be matched, as in {"$closed": ["x"]}.
+//
+// This is implemented by giving each closed object a synthetic member whose name is closedObjectMarker
+// followed by the sorted names the pattern mentions, separated by closedNameSeparator, and adding an
+// "exists": false test for that member to the pattern. Neither byte can occur in UTF-8, so these names can't
+// collide with anything in a real event and sort after every real name. When the segmentsTree sees such a
+// member name, it remembers the set of allowed names, and when the flattener reads an object member whose
+// name isn't in that set, it reports a Field with the synthetic name. That Field's existence causes the
+// exists:false test, and thus the pattern, to fail.
+// Since the marker is distinct for each set of allowed names, patterns which close the same object with
+// different member lists don't interfere with each other.
+const (
+	closedMemberName         = "$closed"
+	closedObjectMarker  byte = 0xf7
+	closedNameSeparator byte = 0xf8
+)
+
+// closedViolationBytes is the Val of the Field reported for a member of a closed object that the pattern
+// doesn't mention; it doesn't matter what it is because only exists:false tests apply to such Fields.
+var closedViolationBytes = []byte("true")
+
+// closedObject records, for a segmentsTree node, one set of member names which some pattern has said are the
+// only ones allowed, and the Path of the synthetic Field to report when any other member appears.
+type closedObject struct {
+	path    []byte
+	allowed map[string]bool
+}
+
+// closedObjectsTracker is implemented by SegmentsTreeTrackers which support closed objects. It's not part of
+// SegmentsTreeTracker so as not to break existing implementations; the flattener checks for it with a type
+// assertion.
+type closedObjectsTracker interface {
+	// closedPathsViolatedBy appends to paths the Path values of the synthetic Fields which should be
+	// reported when an object member named segment appears at this level, and returns the result.
+	closedPathsViolatedBy(segment []byte, paths [][]byte) [][]byte
+}
+
+// readClosedMember is called by readPatternObject on encountering the "$closed" member name. If the value
+// is true or false, the member says whether the object is closed, and isMarker is true. Otherwise, it is an
+// ordinary member which happens to be named "$closed", and readClosedMember reads it as such.
+func readClosedMember(pb *patternBuild) (closed bool, isMarker bool, err error) {
+	t, err := pb.jd.Token()
+	if errors.Is(err, io.EOF) {
+		return false, false, errors.New("pattern ends mid-field")
+	} else if err != nil {
+		return false, false, errors.New("pattern malformed: " + err.Error())
+	}
+	if b, ok := t.(bool); ok {
+		return b, true, nil
+	}
+	pb.path = append(pb.path, closedMemberName)
+	err = readPatternValue(pb, t)
+	pb.path = pb.path[:len(pb.path)-1]
+	return false, false, err
+}
+
+// closedObjectField builds the exists:false patternField which enforces the closure of the object at
+// pb.path, given the member names mentioned in the pattern for that object
+func closedObjectField(pb *patternBuild, memberNames []string) *patternField {
+	slices.Sort(memberNames)
+	memberNames = slices.Compact(memberNames)
+	marker := string([]byte{closedObjectMarker}) + strings.Join(memberNames, string([]byte{closedNameSeparator}))
+	segments := append(slices.Clone(pb.path), marker)
+	return &patternField{
+		path: strings.Join(segments, SegmentSeparator),
+		vals: []typedVal{{vType: existsFalseType}},
+	}
+}
+
+// addClosedObject is called when a segment beginning with closedObjectMarker is first added to the tree
+func (p *segmentsTree) addClosedObject(segment string, path []byte) {
+	allowed := make(map[string]bool)
+	names := segment[1:]
+	if len(names) > 0 {
+		for _, name := range strings.Split(names, string([]byte{closedNameSeparator})) {
+			allowed[name] = true
+		}
+	}
+	// copy-on-write, since copies of the segmentsTree share the slice
+	closed := make([]closedObject, len(p.closed), len(p.closed)+1)
+	copy(closed, p.closed)
+	p.closed = append(closed, closedObject{path: path, allowed: allowed})
+}
+
+// closedPathsViolatedBy implements closedObjectsTracker
+func (p *segmentsTree) closedPathsViolatedBy(segment []byte, paths [][]byte) [][]byte {
+	if len(p.closed) == 0 {
+		return paths
+	}
+	var buf [maxStackFoldedSegment]byte
+	segment = p.key(buf[:0], segment)
+	for _, c := range p.closed {
+		if !c.allowed[string(segment)] {
+			paths = append(paths, c.path)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,156 @@
+package quamina
+
+import (
+	"testing"
+)
+
+func TestClosedObjects(t *testing.T) {
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{"closedDetail", `{"detail": {"$closed": true, "a": [1], "b": [{"exists": true}]}}`},
+		{"closedWithC", `{"detail": {"$closed": true, "a": [1], "b": [{"exists": true}], "c": [3]}}`},
+		{"openDetail", `{"detail": {"a": [1]}}`},
+		{"closedRoot", `{"$closed": true, "x": ["y"]}`},
+		{"closedNested", `{"outer": {"$closed": true, "inner": {"$closed": true, "z": [true]}}}`},
+	}
+	events := []struct {
+		event   string
+		matches []string
+	}{
+		{`{"detail": {"a": 1, "b": 2}}`, []string{"closedDetail", "openDetail"}},
+		{`{"detail": {"b": "x", "a": 1, "c": 3}}`, []string{"closedWithC", "openDetail"}},
+		{`{"detail": {"a": 1, "b": 2, "d": {"e": [1, 2]}}}`, []string{"openDetail"}},
+		{`{"detail": {"a": 1, "b": 2, "c": 4}}`, []string{"openDetail"}},
+		{`{"x": "y"}`, []string{"closedRoot"}},
+		{`{"x": "y", "detail": {"a": 1}}`, []string{"openDetail"}},
+		{`{"outer": {"inner": {"z": true}}}`, []string{"closedNested"}},
+		{`{"outer": {"inner": {"z": true, "q": 1}}}`, []string{}},
+		{`{"outer": {"inner": {"z": true}, "q": 1}}`, []string{}},
+		{`{"outer": {"q": 1, "inner": {"z": true}}}`, []string{}},
+	}
+
+	q, _ := New()
+	for _, p := range patterns {
+		if err := q.AddPattern(p.name, p.pattern); err != nil {
+			t.Fatalf("AddPattern %s: %s", p.name, err.Error())
+		}
+	}
+	for _, e := range events {
+		matches, err := q.MatchesForEvent([]byte(e.event))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(matches) != len(e.matches) {
+			t.Errorf("%s: wanted %v got %v", e.event, e.matches, matches)
+			continue
+		}
+		for _, want := range e.matches {
+			if !containsX(matches, want) {
+				t.Errorf("%s: wanted %v got %v", e.event, e.matches, matches)
+			}
+		}
+	}
+}
+
+func TestClosedObjectsInArrays(t *testing.T) {
+	q, _ := New()
+	if err := q.AddPattern("p", `{"items": {"$closed": true, "id": [{"exists": true}]}}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"items": [{"id": 1}, {"id": 2}]}`))
+	if len(matches) != 1 {
+		t.Errorf("should match, got %v", matches)
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"items": [{"id": 1}, {"id": 2, "extra": 3}]}`))
+	if len(matches) != 0 {
+		t.Errorf("should not match, got %v", matches)
+	}
+}
+
+func TestClosedObjectsWithOptions(t *testing.T) {
+	q, _ := New(WithCaseInsensitiveFieldNames(true), WithPatternDeletion(true))
+	if err := q.AddPattern("p", `{"Detail": {"$closed": true, "UserID": ["u"]}}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"detail": {"userId": "u"}}`))
+	if len(matches) != 1 {
+		t.Errorf("should match, got %v", matches)
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"detail": {"userId": "u", "other": 1}}`))
+	if len(matches) != 0 {
+		t.Errorf("should not match, got %v", matches)
+	}
+	if err := q.DeletePatterns("p"); err != nil {
+		t.Error(err.Error())
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"detail": {"userId": "u"}}`))
+	if len(matches) != 0 {
+		t.Errorf("deleted pattern matched: %v", matches)
+	}
+}
+
+func TestClosedObjectsErrors(t *testing.T) {
+	bad := []string{
+		`{"detail": {"$closed": "yes", "a": [1]}}`,
+		`{"detail": {"$closed": 1, "a": [1]}}`,
+		`{"detail": {"$closed": null, "a": [1]}}`,
+		`{"detail": {"$closed"`,
+	}
+	for _, b := range bad {
+		_, err := patternFromJSON([]byte(b))
+		if err == nil {
+			t.Errorf("accepted %s", b)
+		}
+	}
+
+	// $closed: false is a no-op
+	fields, err := patternFromJSON([]byte(`{"detail": {"$closed": false, "a": [1]}}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(fields) != 1 || fields[0].path != "detail\na" {
+		t.Errorf("$closed:false produced %v", fields)
+	}
+}
+
+func TestClosedMemberAsField(t *testing.T) {
+	// "$closed" with a value other than true or false is an ordinary member
+	q, _ := New()
+	patterns := []struct {
+		x       string
+		pattern string
+	}{
+		{"array", `{"$closed": ["x"]}`},
+		{"boolean", `{"detail": {"$closed": [true], "a": [1]}}`},
+		{"object", `{"$closed": {"a": [1]}}`},
+		{"closedToo", `{"outer": {"$closed": true, "$closed": [2]}}`},
+	}
+	for _, p := range patterns {
+		if err := q.AddPattern(p.x, p.pattern); err != nil {
+			t.Fatalf("%s: %s", p.pattern, err.Error())
+		}
+	}
+	tests := []struct {
+		event string
+		want  []X
+	}{
+		{`{"$closed": "x"}`, []X{"array"}},
+		{`{"$closed": "y"}`, nil},
+		{`{"detail": {"$closed": true, "a": 1, "b": 2}}`, []X{"boolean"}},
+		{`{"detail": {"a": 1}}`, nil},
+		{`{"$closed": {"a": 1}}`, []X{"object"}},
+		{`{"outer": {"$closed": 2}}`, []X{"closedToo"}},
+		{`{"outer": {"$closed": 2, "other": 3}}`, nil},
+	}
+	for _, tt := range tests {
+		matches, err := q.MatchesForEvent([]byte(tt.event))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !sameXs(tt.want, matches) {
+			t.Errorf("%s: wanted %v got %v", tt.event, tt.want, matches)
+		}
+	}
+}
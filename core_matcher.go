@@ -11,6 +11,8 @@ import (
 	"bytes"
 	"cmp"
 	"errors"
+	"fmt"
+	"runtime"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -30,6 +32,12 @@ type coreMatcher struct {
 	// never accessed concurrently. Lives here (not a sync.Pool) so the maps are
 	// never evicted mid-build; see epsilonClosureInto.
 	closureBufs *closureBuffers
+	// opts holds the settings which affect how patterns are compiled; it does not change after construction
+	opts *patternOptions
+	// deletion is the record of the automaton which deletePatterns needs, if deletion is enabled; see deletion.go
+	deletion *deletionIndex
+	// lazyDFAs bounds the memory used by the lazyDFAs of all the valueMatchers built in BuiltLazily mode
+	lazyDFAs *lazyDFABudget
 }
 
 // coreFields groups the updateable fields in coreMatcher.
@@ -37,16 +45,42 @@ type coreMatcher struct {
 // segmentsTree is a structure that encodes which fields appear in the Patterns that are added to the coreMatcher.
 // It is built during calls to addPattern. It implements SegmentsTreeTracker, which is used by the event flattener
 // to optimize the flattening process by skipping the processing of fields which are not used in any pattern.
+// hasExclusions records whether any exclusion patterns have been added, in which case match results need
+// filtering; see exclusion.go.
+// maxQuantifier is the largest range quantifier in the regexps of the patterns that have been added, and
+// largeQuantifiers is the number of patterns with range quantifiers larger than regexpLargeQuantifier; they are
+// reported by getStats.
+// frozen is the automaton compiled by freeze, if it has been called; state then holds only the patterns added
+// since, and both are used for matching. See frozen_matcher.go.
+// deleted holds the X values whose patterns have been deleted since frozen was compiled, and which are to be
+// removed from its results; see deletion.go.
 type coreFields struct {
-	state        *fieldMatcher
-	segmentsTree *segmentsTree
+	state         *fieldMatcher
+	segmentsTree  *segmentsTree
+	hasExclusions bool
+	frozen        *frozenMatcher
+	deleted       map[X]bool
+
+	maxQuantifier    int
+	largeQuantifiers int
 }
 
+// regexpLargeQuantifier is the size above which range quantifiers are counted in coreFields.largeQuantifiers
+const regexpLargeQuantifier = 100
+
 func newCoreMatcher() *coreMatcher {
-	m := coreMatcher{closureBufs: newClosureBuffers()}
+	return newCoreMatcherWithOptions(&patternOptions{})
+}
+
+func newCoreMatcherWithOptions(opts *patternOptions) *coreMatcher {
+	m := coreMatcher{closureBufs: newClosureBuffers(), opts: opts, lazyDFAs: newLazyDFABudget(lazyDFAMaxStates)}
+	tree := newSegmentsIndex()
+	if opts.foldFieldNames {
+		tree = newFoldingSegmentsIndex()
+	}
 	m.updateable.Store(&coreFields{
 		state:        newFieldMatcher(),
-		segmentsTree: newSegmentsIndex(),
+		segmentsTree: tree,
 	})
 	return &m
 }
@@ -64,14 +98,11 @@ func (m *coreMatcher) addPattern(x X, patternJSON string, buildMode MatcherBuild
 // addPatternWithPrinter can be called from debugging and under-development code to allow viewing pretty-printed
 // NFAs
 func (m *coreMatcher) addPatternWithPrinter(x X, patternJSON string, printer printer, buildMode MatcherBuildMode) error {
-	patternFields, err := patternFromJSON([]byte(patternJSON))
+	patternFields, err := m.parsePattern(patternJSON)
 	if err != nil {
 		return err
 	}
 
-	// sort the pattern fields lexically
-	slices.SortFunc(patternFields, func(a, b *patternField) int { return cmp.Compare(a.path, b.path) })
-
 	// only one thread can be updating at a time
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -81,21 +112,163 @@ func (m *coreMatcher) addPatternWithPrinter(x X, patternJSON string, printer pri
 	m.closureBufs.reset()
 
 	// we build up the new coreMatcher state in freshStart so that we can atomically switch it in once complete
+	freshStart := m.freshFields()
+	freshStart.notePattern(x, patternFields)
+	if m.deletion != nil {
+		m.deletion.buildMode = buildMode
+	}
+	addPatternFields(freshStart.state, x, patternFields, printer, m.closureBufs, buildMode, m.opts, m.deletion)
+	m.updateable.Store(freshStart)
 
-	freshStart := &coreFields{}
+	return nil
+}
+
+// addPatterns adds many patterns at once. Rather than adding each to the automaton in turn, it divides them into
+// groups by the transition their first field takes from the start state. The parts of the automaton which
+// different groups add are reached only through those transitions, so they are independent, and each group is
+// built on its own goroutine; see patternGroup.build. Then the valueMatchers which the groups have added or
+// changed are prepared for matching, also in parallel, and finally a new start state links the groups' results
+// into the automaton. The groups' automata are merged with the current one by creating new fieldMatchers and
+// valueMatchers wherever the two collide, rather than updating the current ones, so the result is published
+// with a single atomic store and matchesForFields sees either none of the patterns or all of them. If any
+// pattern is invalid, none is added.
+// Patterns whose first fields take the same transition are built on the same goroutine, so when all the
+// patterns begin with the same field, only their preparation is done in parallel.
+func (m *coreMatcher) addPatterns(specs []PatternSpec, buildMode MatcherBuildMode) error {
+	return m.addPatternsWithPrinter(specs, sharedNullPrinter, buildMode)
+}
+
+// addPatternsWithPrinter is to addPatterns as addPatternWithPrinter is to addPattern. The groups are built
+// concurrently, so printer must be safe for concurrent use.
+func (m *coreMatcher) addPatternsWithPrinter(specs []PatternSpec, printer printer, buildMode MatcherBuildMode) error {
+	parsed := make([][]*patternField, len(specs))
+	for i, spec := range specs {
+		patternFields, err := m.parsePattern(spec.Pattern)
+		if err != nil {
+			return fmt.Errorf("pattern %d: %w", i, err)
+		}
+		parsed[i] = patternFields
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	freshStart := m.freshFields()
+	var groups []*patternGroup
+	byTransition := make(map[groupKey]*patternGroup)
+	// patterns with no fields to match add their X to the start state
+	var startMatches []X
+	for i, spec := range specs {
+		freshStart.notePattern(spec.X, parsed[i])
+		key, ok := firstTransition(parsed[i])
+		if !ok {
+			startMatches = append(startMatches, spec.X)
+			continue
+		}
+		group := byTransition[key]
+		if group == nil {
+			group = &patternGroup{key: key}
+			byTransition[key] = group
+			groups = append(groups, group)
+		}
+		group.xs = append(group.xs, spec.X)
+		group.patterns = append(group.patterns, parsed[i])
+	}
+
+	current := freshStart.state.fields()
+	inParallel(len(groups), m.closureBufs, func(i int, bufs *closureBuffers) {
+		groups[i].build(current, printer, bufs, buildMode, m.opts, m.deletion != nil)
+	})
+	var unprepared []*valueMatcher
+	for _, group := range groups {
+		unprepared = append(unprepared, group.unprepared...)
+	}
+	inParallel(len(unprepared), m.closureBufs, func(i int, bufs *closureBuffers) {
+		vmFields := unprepared[i].getFieldsForUpdate()
+		vmFields.prepareAutomaton(bufs, buildMode)
+		unprepared[i].update(vmFields)
+	})
+	start := linkGroups(current, groups, startMatches)
+
+	if m.deletion != nil {
+		m.deletion.buildMode = buildMode
+		log := &mergeLog{}
+		for _, group := range groups {
+			m.deletion.absorb(group.idx)
+			log.add(group.log)
+		}
+		// the new start state replaces the current one and the groups' own start states, which have no matches
+		log.fieldMatcher(freshStart.state, nil, start)
+		m.deletion.rebase(log)
+	}
+	freshStart.state = start
+	m.updateable.Store(freshStart)
+	return nil
+}
+
+// parsePattern compiles a pattern and sorts its fields lexically
+func (m *coreMatcher) parsePattern(patternJSON string) ([]*patternField, error) {
+	patternFields, err := patternFromJSONWithOptions([]byte(patternJSON), m.opts)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(patternFields, func(a, b *patternField) int { return cmp.Compare(a.path, b.path) })
+	return patternFields, nil
+}
+
+// freshFields returns a copy of the current coreFields, with its own segmentsTree, to be updated and then
+// stored. It must be called with the lock held.
+func (m *coreMatcher) freshFields() *coreFields {
 	currentFields := m.fields()
+	freshStart := &coreFields{}
 	freshStart.segmentsTree = currentFields.segmentsTree.copy()
 	freshStart.state = currentFields.state
+	freshStart.hasExclusions = currentFields.hasExclusions
+	freshStart.maxQuantifier = currentFields.maxQuantifier
+	freshStart.largeQuantifiers = currentFields.largeQuantifiers
+	freshStart.frozen = currentFields.frozen
+	freshStart.deleted = currentFields.deleted
+	return freshStart
+}
+
+// notePattern updates everything in coreFields but the automaton to reflect the addition of a pattern
+func (fields *coreFields) notePattern(x X, patternFields []*patternField) {
+	if _, ok := x.(exclusionX); ok {
+		fields.hasExclusions = true
+	}
 
 	// Add paths to the segments tree index.
 	for _, field := range patternFields {
-		freshStart.segmentsTree.add(field.path)
+		fields.segmentsTree.add(field.path)
+
+		// equals-field patterns also need the flattener to report the fields they refer to
+		for _, val := range field.vals {
+			if val.vType == equalsFieldType {
+				fields.segmentsTree.add(val.val)
+			}
+		}
+	}
+	patternQuantifier := 0
+	for _, field := range patternFields {
+		for _, val := range field.vals {
+			if val.vType == regexpType {
+				patternQuantifier = max(patternQuantifier, regexpMaxQuantifier(val.parsedRegexp))
+			}
+		}
 	}
+	fields.maxQuantifier = max(fields.maxQuantifier, patternQuantifier)
+	if patternQuantifier > regexpLargeQuantifier {
+		fields.largeQuantifiers++
+	}
+}
 
+// addPatternFields adds a pattern's fields to the automaton beginning at start, recording them in idx if it
+// isn't nil
+func addPatternFields(start *fieldMatcher, x X, patternFields []*patternField, printer printer, bufs *closureBuffers, buildMode MatcherBuildMode, opts *patternOptions, idx *deletionIndex) {
 	// now we add each of the name/value pairs in fields slice to the automaton, starting with the start state -
 	// the addTransition for a field returns a list of the fieldMatchers transitioned to for that name/val
 	// combo.
-	states := []*fieldMatcher{currentFields.state}
+	states := []*fieldMatcher{start}
 	for _, field := range patternFields {
 		// if the field has no values, this is a no-op
 		if len(field.vals) == 0 {
@@ -111,10 +284,15 @@ func (m *coreMatcher) addPatternWithPrinter(x X, patternJSON string, printer pri
 			switch field.vals[0].vType {
 			case existsTrueType:
 				ns = state.addExists(true, field)
-			case existsFalseType:
+			case existsFalseType, existsFalseIgnoringNullType:
 				ns = state.addExists(false, field)
+			case equalsFieldType:
+				ns = state.addEqualsField(field)
 			default:
-				ns = state.addTransition(field, printer, m.closureBufs, buildMode)
+				ns = state.addTransition(field, printer, bufs, buildMode, opts)
+			}
+			if idx != nil {
+				idx.noteTransitions(state, field, ns)
 			}
 			nextStates = append(nextStates, ns...)
 		}
@@ -125,15 +303,263 @@ func (m *coreMatcher) addPatternWithPrinter(x X, patternJSON string, printer pri
 	//  by matching each field in the pattern, so update the matches value to indicate this.
 	for _, endState := range states {
 		endState.addMatch(x)
+		if idx != nil {
+			idx.noteEnd(x, endState)
+		}
 	}
-	m.updateable.Store(freshStart)
+}
 
-	return nil
+// groupKey identifies a transition from the start state; see addPatterns
+type groupKey struct {
+	kind transitionKind
+	path string
+}
+
+// firstTransition returns the transition from the start state which addPatternFields follows for a pattern,
+// or false if the pattern has no fields to match
+func firstTransition(patternFields []*patternField) (groupKey, bool) {
+	for _, field := range patternFields {
+		if len(field.vals) > 0 {
+			return groupKey{kind: transitionKindOf(field), path: field.path}, true
+		}
+	}
+	return groupKey{}, false
+}
+
+// patternGroup is a set of patterns whose first fields take the same transition from the start state, which is
+// built by addPatterns on a goroutine of its own. The build's results are the valueMatcher or fieldMatcher, or
+// for equals-field transitions the map of fieldMatchers, which the transition is to lead to; the valueMatchers
+// which need to be prepared for matching; the merges which were done, in log; and, if deletion is enabled, the
+// records of the automaton that was built, in idx.
+type patternGroup struct {
+	key      groupKey
+	xs       []X
+	patterns [][]*patternField
+
+	vm         *valueMatcher
+	fm         *fieldMatcher
+	refs       map[string]*fieldMatcher
+	unprepared []*valueMatcher
+	log        *mergeLog
+	idx        *deletionIndex
+}
+
+// build adds the group's patterns to an automaton of their own, without computing epsilon closures or converting
+// NFAs to DFAs, then merges that with the part of the current automaton which is reached through the same
+// transition from start, the fields of the current start state. The merge doesn't prepare the valueMatchers it
+// creates either, so that each valueMatcher is prepared only once, whether or not it has been merged.
+func (group *patternGroup) build(start *fmFields, printer printer, bufs *closureBuffers, buildMode MatcherBuildMode, opts *patternOptions, deletion bool) {
+	group.log = &mergeLog{}
+	if deletion {
+		group.idx = newDeletionIndex()
+		group.idx.buildMode = buildMode
+	}
+	batch := newFieldMatcher()
+	for i, x := range group.xs {
+		addPatternFields(batch, x, group.patterns[i], printer, bufs, builtDeferred, opts, group.idx)
+	}
+
+	built := batch.fields()
+	path := group.key.path
+	switch group.key.kind {
+	case valueTransition:
+		group.vm = built.transitions[path]
+		if vm, ok := start.transitions[path]; ok {
+			group.vm = mergeValueMatchers(vm, group.vm, bufs, builtDeferred, group.log)
+		}
+	case equalsFieldTransition:
+		group.refs = mergeFMMaps(start.equalsField[path], built.equalsField[path], bufs, builtDeferred, group.log)
+	default:
+		group.fm = built.pathEdges(group.key.kind)[path]
+		if fm, ok := start.pathEdges(group.key.kind)[path]; ok {
+			group.fm = mergeFieldMatchers(fm, group.fm, bufs, builtDeferred, group.log)
+		}
+	}
+
+	// the valueMatchers which were built, except those replaced by merged ones, and the merged ones
+	var vms []*valueMatcher
+	collectAutomata(batch, &vms, make(map[*fieldMatcher]bool))
+	replaced := make(map[*valueMatcher]bool, len(group.log.valueMatchers))
+	for _, merged := range group.log.valueMatchers {
+		replaced[merged.from2] = true
+		if needsPreparing(merged.to.fields()) {
+			group.unprepared = append(group.unprepared, merged.to)
+		}
+	}
+	for _, vm := range vms {
+		if !replaced[vm] {
+			group.unprepared = append(group.unprepared, vm)
+		}
+	}
 }
 
-// deletePattern not implemented by coreMatcher
-func (m *coreMatcher) deletePatterns(_ X) error {
-	return errors.New("operation not supported")
+// linkGroups returns a start state with the transitions and matches of start, the fields of the current start
+// state, except that the transitions taken by the groups lead to their results, and with the additional matches
+// xs
+func linkGroups(start *fmFields, groups []*patternGroup, xs []X) *fieldMatcher {
+	fields := &fmFields{
+		matches:                 append(slices.Clip(start.matches), xs...),
+		transitions:             copyMap(start.transitions),
+		existsTrue:              copyMap(start.existsTrue),
+		existsFalse:             copyMap(start.existsFalse),
+		existsFalseIgnoringNull: copyMap(start.existsFalseIgnoringNull),
+		equalsField:             copyMap(start.equalsField),
+	}
+	for _, group := range groups {
+		switch group.key.kind {
+		case valueTransition:
+			fields.transitions[group.key.path] = group.vm
+		case equalsFieldTransition:
+			fields.equalsField[group.key.path] = group.refs
+		default:
+			fields.pathEdges(group.key.kind)[group.key.path] = group.fm
+		}
+	}
+	fm := &fieldMatcher{}
+	fm.update(fields)
+	return fm
+}
+
+// copyMap returns a copy of m, which is never nil
+func copyMap[V any](m map[string]V) map[string]V {
+	fresh := make(map[string]V, len(m))
+	for k, v := range m {
+		fresh[k] = v
+	}
+	return fresh
+}
+
+// inParallel calls work for each i from 0 to n-1, on up to GOMAXPROCS goroutines. Each goroutine has its own
+// closureBuffers, which are reset before each call, since they only need to hold the working set of one
+// automaton; bufs is used by the first of them.
+func inParallel(n int, bufs *closureBuffers, work func(i int, bufs *closureBuffers)) {
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(runtime.GOMAXPROCS(0), n); worker++ {
+		workerBufs := bufs
+		if worker > 0 {
+			workerBufs = newClosureBuffers()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := next.Add(1) - 1; i < int64(n); i = next.Add(1) - 1 {
+				workerBufs.reset()
+				work(int(i), workerBufs)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// needsPreparing returns true if a valueMatcher's automaton is nondeterministic and so needs preparing
+// for matching
+func needsPreparing(vmFields *vmFields) bool {
+	return vmFields.start != nil && vmFields.isNondeterministic
+}
+
+// collectAutomata appends to vms every valueMatcher reachable from fm which needs preparing
+func collectAutomata(fm *fieldMatcher, vms *[]*valueMatcher, seen map[*fieldMatcher]bool) {
+	if seen[fm] {
+		return
+	}
+	seen[fm] = true
+	fields := fm.fields()
+	for _, vm := range fields.transitions {
+		vmFields := vm.fields()
+		if needsPreparing(vmFields) {
+			*vms = append(*vms, vm)
+		}
+		if vmFields.singletonTransition != nil {
+			collectAutomata(vmFields.singletonTransition, vms, seen)
+		}
+		for _, p := range vmFields.predicates {
+			collectAutomata(p.next, vms, seen)
+		}
+		if vmFields.start != nil {
+			for _, next := range reachableFieldTransitions(vmFields.start) {
+				collectAutomata(next, vms, seen)
+			}
+		}
+	}
+	for _, next := range fields.existsTrue {
+		collectAutomata(next, vms, seen)
+	}
+	for _, next := range fields.existsFalse {
+		collectAutomata(next, vms, seen)
+	}
+	for _, next := range fields.existsFalseIgnoringNull {
+		collectAutomata(next, vms, seen)
+	}
+	for _, byRef := range fields.equalsField {
+		for _, next := range byRef {
+			collectAutomata(next, vms, seen)
+		}
+	}
+}
+
+// compact replaces the DFAs in the automaton with their minimal equivalents. It holds the lock, so it
+// can't run in parallel with addPattern, but matchesForFields can proceed while it works.
+func (m *coreMatcher) compact() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	compactFieldMatcher(m.fields().state, make(map[*fieldMatcher]bool))
+}
+
+// freeze compiles the automaton, including any frozen form from a previous call, into a frozenMatcher. Like
+// compact, it holds the lock while it works but doesn't block matchesForFields.
+func (m *coreMatcher) freeze(buildMode MatcherBuildMode) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	freshStart := *m.fields() // struct copy
+	freshStart.frozen = freezeFieldMatcher(m.wholeAutomaton(buildMode))
+	if buildMode == BuiltLazily {
+		freshStart.frozen.prepareLazyDFAs()
+	}
+	freshStart.state = newFieldMatcher()
+	freshStart.deleted = nil
+	if m.deletion != nil {
+		m.deletion.reset(freshStart.frozen)
+	}
+	m.updateable.Store(&freshStart)
+}
+
+// wholeAutomaton returns the automaton, merged with the frozen one if there is one, without the patterns
+// which have been deleted from the frozen one. It must be called with the lock held.
+func (m *coreMatcher) wholeAutomaton(buildMode MatcherBuildMode) *fieldMatcher {
+	current := m.fields()
+	if current.frozen == nil {
+		return current.state
+	}
+	thawed := current.frozen.thaw()
+	if len(current.deleted) > 0 {
+		withoutDeleted(thawed, current.deleted, make(map[*fieldMatcher]bool))
+	}
+	return mergeFieldMatchers(thawed, current.state, m.closureBufs, buildMode, nil)
+}
+
+// deletePatterns removes the patterns identified by x, including exclusion patterns, if deletion is enabled;
+// see deletion.go
+func (m *coreMatcher) deletePatterns(x X) error {
+	if m.deletion == nil {
+		return errors.New("operation not supported")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.closureBufs.reset()
+	current := m.fields()
+	m.deletion.deletePatterns(current.state, x, m.closureBufs)
+	if m.deletion.frozenXs[x] {
+		delete(m.deletion.frozenXs, x)
+		freshStart := *current // struct copy
+		freshStart.deleted = make(map[X]bool, len(current.deleted)+1)
+		for deleted := range current.deleted {
+			freshStart.deleted[deleted] = true
+		}
+		freshStart.deleted[x] = true
+		m.updateable.Store(&freshStart)
+	}
+	return nil
 }
 
 // matchesForJSONEvent calls the flattener to pull the fields out of the event and
@@ -183,8 +609,18 @@ func emptyFields() []Field {
 
 // matchesForFields takes a list of Field structures, sorts them by pathname, and launches the field-matching
 // process. The fields in a pattern to match are similarly sorted; thus running an automaton over them works.
-// No error can be returned but the matcher interface requires one, and it is used by the pruner implementation
+// No error can be returned but the matcher interface requires one
 func (m *coreMatcher) matchesForFields(fields []Field, bufs *nfaBuffers) ([]X, error) {
+	xs := m.unfilteredMatchesForFields(fields, bufs)
+	if m.fields().hasExclusions {
+		xs = applyExclusions(xs)
+	}
+	return xs, nil
+}
+
+// unfilteredMatchesForFields does the work of matchesForFields, except that the results may include
+// exclusionX values, which the caller must apply.
+func (m *coreMatcher) unfilteredMatchesForFields(fields []Field, bufs *nfaBuffers) []X {
 	if len(fields) == 0 {
 		fields = emptyFields()
 	} else {
@@ -193,91 +629,153 @@ func (m *coreMatcher) matchesForFields(fields []Field, bufs *nfaBuffers) ([]X, e
 	// Reuse the matchSet from buffers to reduce allocations
 	matches := bufs.getMatches()
 	matches.reset()
+	bufs.lazyDFAs = m.lazyDFAs
+	bufs.exactIntegers = m.opts.exactIntegers
 	// Reset transmap depth for this match operation
 	if tm := bufs.transmap; tm != nil {
 		tm.resetDepth()
 	}
 	cmFields := m.fields()
 
+	// the frozen automaton goes first, so that its results for deleted patterns can be removed
+	if cmFields.frozen != nil {
+		cmFields.frozen.matchesForFields(fields, matches, bufs)
+		if cmFields.deleted != nil {
+			dropDeleted(matches, cmFields.deleted)
+		}
+	}
+
 	// for each of the fields, we'll try to match the automaton start state to that field - the tryToMatch
 	// routine will, in the case that there's a match, call itself to see if subsequent fields after the
 	// first matched will transition through the machine and eventually achieve a match
+	bufs.fieldMatch.matchAll(pointerAutomaton{}, cmFields.state, fields, matches, bufs)
+	return matches.matchesInto(bufs.resultBuf[:0])
+}
+
+// fieldAutomaton is what matching needs to know about an automaton whose fieldMatchers are Fs. It's
+// provided by pointerAutomaton for the coreMatcher's own automaton, whose fieldMatchers are *fieldMatcher,
+// and by frozenMatcher, whose fieldMatchers are indexes, so that the field-level logic of matching, in
+// fieldMatch, is the same for both; only running a field's value through a valueMatcher, in transitionOn,
+// differs.
+type fieldAutomaton[F comparable] interface {
+	// matchesOf returns the Xs which are matched on arriving at fm
+	matchesOf(fm F) []X
+	// existsTrueOn returns the fieldMatcher which an exists:true transition on path leads to from fm
+	existsTrueOn(fm F, path []byte) (F, bool)
+	// eachExistsFalse calls m.tryExistsFalse for each of fm's exists:false transitions
+	eachExistsFalse(fm F, index int, m *fieldMatch[F])
+	// eachEqualsField calls m.tryEqualsField for each of fm's equals-field transitions on the path of the
+	// field at index
+	eachEqualsField(fm F, index int, m *fieldMatch[F])
+	// transitionOn returns the fieldMatchers which the field's value leads to from fm, in a buffer which
+	// stays valid until the popTransitions which matches the pushTransitions before it
+	transitionOn(fm F, field *Field, bufs *nfaBuffers) []F
+	pushTransitions(bufs *nfaBuffers)
+	popTransitions(bufs *nfaBuffers)
+}
+
+// fieldMatch is the matching of an event's fields against an automaton. It lives in the nfaBuffers, so
+// that passing it around doesn't allocate.
+type fieldMatch[F comparable] struct {
+	automaton fieldAutomaton[F]
+	fields    []Field
+	matches   *matchSet
+	bufs      *nfaBuffers
+}
+
+// matchAll tries to match each of the fields, which must be sorted, to the start state
+func (m *fieldMatch[F]) matchAll(automaton fieldAutomaton[F], start F, fields []Field, matches *matchSet, bufs *nfaBuffers) {
+	m.automaton, m.fields, m.matches, m.bufs = automaton, fields, matches, bufs
 	for i := 0; i < len(fields); i++ {
-		tryToMatch(fields, i, cmFields.state, matches, bufs)
+		m.tryToMatch(i, start)
 	}
-	return matches.matchesInto(bufs.resultBuf[:0]), nil
+	m.automaton, m.fields, m.matches, m.bufs = nil, nil, nil, nil
 }
 
 // tryToMatch tries to match the field at fields[index] to the provided state. If it does match and generate
 // 1 or more transitions to other states, it calls itself recursively to see if any of the remaining fields
 // can continue the process by matching that state.
-func tryToMatch(fields []Field, index int, state *fieldMatcher, matches *matchSet, bufs *nfaBuffers) {
-	stateFields := state.fields()
-
+func (m *fieldMatch[F]) tryToMatch(index int, state F) {
 	// transition on exists:true?
-	existsTrans, ok := stateFields.existsTrue[string(fields[index].Path)]
-	if ok {
-		matches = matches.addXSingleThreaded(existsTrans.fields().matches...)
-		for nextIndex := index + 1; nextIndex < len(fields); nextIndex++ {
-			if noArrayTrailConflict(fields[index].ArrayTrail, fields[nextIndex].ArrayTrail) {
-				tryToMatch(fields, nextIndex, existsTrans, matches, bufs)
-			}
-		}
+	if existsTrans, ok := m.automaton.existsTrueOn(state, m.fields[index].Path); ok {
+		m.transitionTo(index, existsTrans)
 	}
 
 	// an exists:false transition is possible if there is no matching field in the event
-	checkExistsFalse(stateFields, fields, index, matches, bufs)
+	m.checkExistsFalse(index, state)
+
+	// transition on equals-field?
+	m.automaton.eachEqualsField(state, index, m)
 
 	// try to transition through the machine
-	tm := bufs.getTransmap()
-	tm.push()
-	nextStates := state.transitionOn(&fields[index], bufs)
+	m.automaton.pushTransitions(m.bufs)
+	nextStates := m.automaton.transitionOn(state, &m.fields[index], m.bufs)
 
 	// for each state in the possibly-empty list of transitions from this state on fields[index]
 	for _, nextState := range nextStates {
-		nextStateFields := nextState.fields()
-		matches = matches.addXSingleThreaded(nextStateFields.matches...)
-
-		// for each state we've transitioned to, give each subsequent field a chance to
-		//  transition on it, assuming it's not in an object that's in a different element
-		//  of the same array
-		for nextIndex := index + 1; nextIndex < len(fields); nextIndex++ {
-			if noArrayTrailConflict(fields[index].ArrayTrail, fields[nextIndex].ArrayTrail) {
-				tryToMatch(fields, nextIndex, nextState, matches, bufs)
-			}
+		m.transitionTo(index, nextState)
+	}
+	m.automaton.popTransitions(m.bufs)
+}
+
+// transitionTo records the matches of next, which the field at fields[index] has led to
+func (m *fieldMatch[F]) transitionTo(index int, next F) {
+	fields := m.fields
+	m.matches = m.matches.addXSingleThreaded(m.automaton.matchesOf(next)...)
+
+	// for each state we've transitioned to, give each subsequent field a chance to
+	//  transition on it, assuming it's not in an object that's in a different element
+	//  of the same array
+	for nextIndex := index + 1; nextIndex < len(fields); nextIndex++ {
+		if noArrayTrailConflict(fields[index].ArrayTrail, fields[nextIndex].ArrayTrail) {
+			m.tryToMatch(nextIndex, next)
 		}
-		// now we've run out of fields to match this state against. But suppose it has an exists:false
-		// transition, and it so happens that the exists:false pattern field is lexically larger than the other
-		// fields and that in fact such a field does not exist. That state would be left hanging. So…
-		checkExistsFalse(nextStateFields, fields, index, matches, bufs)
-	}
-	tm.pop()
-}
-
-func checkExistsFalse(stateFields *fmFields, fields []Field, index int, matches *matchSet, bufs *nfaBuffers) {
-	for existsFalsePath, existsFalseTrans := range stateFields.existsFalse {
-		// it seems like there ought to be a more state-machine-idiomatic way to do this, but
-		// I thought of a few and none of them worked.  Quite likely someone will figure it out eventually.
-		// Could get slow for big events with hundreds or more fields (not that I've ever seen that) - might
-		// be worthwhile switching to binary search at some field count or building a map[]boolean in addPattern
-		var i int
-		var thisFieldIsAnExistsFalse bool
-		for i = 0; i < len(fields); i++ {
-			if string(fields[i].Path) == existsFalsePath {
-				if i == index {
-					thisFieldIsAnExistsFalse = true
-				}
-				break
+	}
+	// now we've run out of fields to match this state against. But suppose it has an exists:false
+	// transition, and it so happens that the exists:false pattern field is lexically larger than the other
+	// fields and that in fact such a field does not exist. That state would be left hanging. So…
+	m.checkExistsFalse(index, next)
+}
+
+func (m *fieldMatch[F]) checkExistsFalse(index int, state F) {
+	m.automaton.eachExistsFalse(state, index, m)
+}
+
+// tryEqualsField takes the equals-field transition to equalsTrans if there's another field whose path is
+// refPath and whose value is the same as that of the field at fields[index]
+func (m *fieldMatch[F]) tryEqualsField(index int, refPath string, equalsTrans F) {
+	if equalsFieldPresent(m.fields, index, refPath, m.bufs.exactIntegers) {
+		m.transitionTo(index, equalsTrans)
+	}
+}
+
+// tryExistsFalse takes the exists:false transition to existsFalseTrans if there is no field in the event
+// whose path is existsFalsePath, not counting null-valued fields if ignoreNull is set.
+func (m *fieldMatch[F]) tryExistsFalse(index int, existsFalsePath string, existsFalseTrans F, ignoreNull bool) {
+	fields := m.fields
+	// it seems like there ought to be a more state-machine-idiomatic way to do this, but
+	// I thought of a few and none of them worked.  Quite likely someone will figure it out eventually.
+	// Could get slow for big events with hundreds or more fields (not that I've ever seen that) - might
+	// be worthwhile switching to binary search at some field count or building a map[]boolean in addPattern
+	for i := 0; i < len(fields); i++ {
+		if string(fields[i].Path) == existsFalsePath {
+			if !ignoreNull || !bytes.Equal(fields[i].Val, nullBytes) {
+				return
 			}
 		}
-		if i == len(fields) {
-			matches = matches.addXSingleThreaded(existsFalseTrans.fields().matches...)
-			if thisFieldIsAnExistsFalse {
-				tryToMatch(fields, index+1, existsFalseTrans, matches, bufs)
-			} else {
-				tryToMatch(fields, index, existsFalseTrans, matches, bufs)
-			}
+	}
+	m.matches = m.matches.addXSingleThreaded(m.automaton.matchesOf(existsFalseTrans)...)
+
+	// if the current field is one of the ignored nulls, it can't be used to go further
+	if string(fields[index].Path) == existsFalsePath {
+		if index+1 < len(fields) {
+			m.tryToMatch(index+1, existsFalseTrans)
+		} else {
+			// nothing follows, but there may be more exists:false transitions to check
+			m.checkExistsFalse(index, existsFalseTrans)
 		}
+	} else {
+		m.tryToMatch(index, existsFalseTrans)
 	}
 }
 
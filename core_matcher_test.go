@@ -1,8 +1,14 @@
 package quamina
 
 import (
+	"context"
 	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestBasicMatching(t *testing.T) {
@@ -103,6 +109,22 @@ func TestExistsFalseOrder(t *testing.T) {
 	}
 }
 
+func TestExistsFalseAfterExistsTrue(t *testing.T) {
+	m := newCoreMatcher()
+	err := m.addPattern("p", `{"a": [{"exists": true}], "b": [{"exists": false}]}`, BuiltForComfort)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := m.matchesForJSONEvent([]byte(`{"a": 1}`))
+	if len(matches) != 1 {
+		t.Errorf("wanted 1 match, got %d", len(matches))
+	}
+	matches, _ = m.matchesForJSONEvent([]byte(`{"a": 1, "b": 2}`))
+	if len(matches) != 0 {
+		t.Errorf("wanted 0 matches, got %d", len(matches))
+	}
+}
+
 func TestFieldNameOrdering(t *testing.T) {
 	j := `{
 		"b": 1
@@ -335,3 +357,299 @@ func fetchFAForPath(t *testing.T, cm *coreMatcher, path string) *faState {
 	vm := cm.fields().state.fields().transitions[path]
 	return vm.fields().start
 }
+
+func TestAddPatterns(t *testing.T) {
+	patterns := frozenTestPatterns()
+	events := frozenTestEvents()
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		oneByOne, _ := New()
+		_ = oneByOne.SetMatcherBuildMode(mode)
+		bulk, _ := New()
+		_ = bulk.SetMatcherBuildMode(mode)
+		check := func(when string) {
+			t.Helper()
+			for _, event := range events {
+				want, _ := oneByOne.MatchesForEvent([]byte(event))
+				got, err := bulk.MatchesForEvent([]byte(event))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				if !sameXs(want, got) {
+					t.Errorf("mode %d, %s, %s: wanted %v got %v", mode, when, event, want, got)
+				}
+			}
+		}
+		addBoth := func(from, to int, prefix string) {
+			var specs []PatternSpec
+			for i := from; i < to; i++ {
+				x := fmt.Sprintf("%s%d", prefix, i)
+				if err := oneByOne.AddPattern(x, patterns[i]); err != nil {
+					t.Fatal(err.Error())
+				}
+				specs = append(specs, PatternSpec{X: x, Pattern: patterns[i]})
+			}
+			if err := bulk.AddPatterns(specs); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+
+		half := len(patterns) / 2
+		addBoth(0, half, "")
+		check("first batch")
+		if err := bulk.AddPattern("single", patterns[half]); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := oneByOne.AddPattern("single", patterns[half]); err != nil {
+			t.Fatal(err.Error())
+		}
+		addBoth(half, len(patterns), "")
+		check("second batch")
+		// every pattern again, colliding with all the existing transitions
+		addBoth(0, len(patterns), "again")
+		check("colliding batch")
+	}
+}
+
+// TestAddPatternsMixedOrder checks that AddPatterns and AddPattern build automata which match the same way,
+// in whatever order the patterns arrive, when the anything-but and shellstyle automata being merged
+// disagree about where the value's match happens
+func TestAddPatternsMixedOrder(t *testing.T) {
+	patterns := []string{
+		`{"a": [{"shellstyle": "*b*"}]}`,
+		`{"a": [{"anything-but": ["x"]}]}`,
+		`{"a": [{"shellstyle": "x*"}]}`,
+	}
+	wanted := map[string][]X{
+		`{"a": ""}`:    {1},
+		`{"a": "abc"}`: {0, 1},
+		`{"a": "b"}`:   {0, 1},
+		`{"a": "x"}`:   {2},
+		`{"a": "xbx"}`: {0, 1, 2},
+		`{"a": "yyy"}`: {1},
+	}
+	orders := [][]int{{0, 1, 2}, {1, 0, 2}, {2, 1, 0}, {1, 2, 0}}
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		for _, order := range orders {
+			oneByOne, _ := New()
+			_ = oneByOne.SetMatcherBuildMode(mode)
+			bulk, _ := New()
+			_ = bulk.SetMatcherBuildMode(mode)
+			var specs []PatternSpec
+			for _, i := range order {
+				if err := oneByOne.AddPattern(i, patterns[i]); err != nil {
+					t.Fatal(err.Error())
+				}
+				specs = append(specs, PatternSpec{X: i, Pattern: patterns[i]})
+			}
+			if err := bulk.AddPatterns(specs); err != nil {
+				t.Fatal(err.Error())
+			}
+			for event, want := range wanted {
+				for name, q := range map[string]*Quamina{"AddPattern": oneByOne, "AddPatterns": bulk} {
+					got, err := q.MatchesForEvent([]byte(event))
+					if err != nil {
+						t.Fatal(err.Error())
+					}
+					if !sameXs(want, got) {
+						t.Errorf("mode %d, %s, order %v, %s: wanted %v got %v", mode, name, order, event, want, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestAddPatternsInvalid(t *testing.T) {
+	q, _ := New()
+	err := q.AddPatterns([]PatternSpec{
+		{X: 1, Pattern: `{"a": ["b"]}`},
+		{X: 2, Pattern: `{"a": "b"}`},
+	})
+	if err == nil || !strings.Contains(err.Error(), "pattern 1") {
+		t.Errorf("wrong error %v", err)
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"a": "b"}`))
+	if len(matches) != 0 {
+		t.Errorf("invalid batch added %v", matches)
+	}
+	if err := q.AddPatterns(nil); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+func TestAddPatternsDeletion(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	err := q.AddPatterns([]PatternSpec{{X: 1, Pattern: `{"a": ["b"]}`}, {X: 2, Pattern: `{"a": ["b"]}`}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := q.DeletePatterns(1); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"a": "b"}`))
+	if !sameXs(matches, []X{2}) {
+		t.Errorf("matched %v", matches)
+	}
+}
+
+// a matching goroutine sees either none of a batch or all of it
+func TestAddPatternsAtomic(t *testing.T) {
+	q, _ := New()
+	const batchSize = 50
+	done := make(chan bool)
+	failed := make(chan string, 1)
+	go func() {
+		reader := q.Copy()
+		for {
+			select {
+			case <-done:
+				close(failed)
+				return
+			default:
+			}
+			matches, _ := reader.MatchesForEvent([]byte(`{"a": "xyz", "b": "1"}`))
+			if len(matches)%batchSize != 0 {
+				select {
+				case failed <- fmt.Sprintf("saw %d matches", len(matches)):
+				default:
+				}
+			}
+		}
+	}()
+	for batch := 0; batch < 20; batch++ {
+		var specs []PatternSpec
+		for i := 0; i < batchSize; i++ {
+			pattern := fmt.Sprintf(`{"a": [{"shellstyle": "*%c*"}], "b": ["1"]}`, "xyz"[i%3])
+			if i%2 == 0 {
+				pattern = `{"a": ["xyz"]}`
+			}
+			specs = append(specs, PatternSpec{X: batch*batchSize + i, Pattern: pattern})
+		}
+		if err := q.AddPatterns(specs); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	close(done)
+	if msg, ok := <-failed; ok {
+		t.Error(msg)
+	}
+}
+
+// TestAddPatternsParallel checks that AddPatterns, which builds and prepares automata in parallel, builds
+// automata which match just as those built by AddPattern do
+func TestAddPatternsParallel(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(8))
+	tests := []struct {
+		name    string
+		pattern func(i int) string
+		event   func(i int) string
+	}{
+		{
+			// many paths, several automata at each level, so the workers share the valueMatchers out
+			name: "many paths",
+			pattern: func(i int) string {
+				return fmt.Sprintf(`{"f%d": [{"shellstyle": "*%d*"}], "g": [{"regexp": "x%d[a-c]+"}]}`, i%20, i, i%7)
+			},
+			event: func(i int) string { return fmt.Sprintf(`{"f%d": "a%db", "g": "x%dab"}`, i%20, i, i%7) },
+		},
+		{
+			// every pattern on the same path, so all of them are merged into the one valueMatcher
+			name: "one path",
+			pattern: func(i int) string {
+				if i%2 == 0 {
+					return fmt.Sprintf(`{"g": [{"shellstyle": "a%d*"}]}`, i)
+				}
+				return fmt.Sprintf(`{"g": [{"regexp": "x%d[a-c]+"}]}`, i)
+			},
+			event: func(i int) string {
+				if i%2 == 0 {
+					return fmt.Sprintf(`{"g": "a%db"}`, i)
+				}
+				return fmt.Sprintf(`{"g": "x%dab"}`, i)
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+			oneByOne, _ := New()
+			_ = oneByOne.SetMatcherBuildMode(mode)
+			bulk, _ := New()
+			_ = bulk.SetMatcherBuildMode(mode)
+			var specs []PatternSpec
+			for i := 0; i < 200; i++ {
+				pattern := tt.pattern(i)
+				if err := oneByOne.AddPattern(i, pattern); err != nil {
+					t.Fatal(err.Error())
+				}
+				specs = append(specs, PatternSpec{X: i, Pattern: pattern})
+			}
+			if err := bulk.AddPatterns(specs); err != nil {
+				t.Fatal(err.Error())
+			}
+			for i := 0; i < 200; i++ {
+				event := tt.event(i)
+				want, _ := oneByOne.MatchesForEvent([]byte(event))
+				got, err := bulk.MatchesForEvent([]byte(event))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				if len(want) == 0 || !sameXs(want, got) {
+					t.Errorf("%s, mode %d, %s: wanted %v got %v", tt.name, mode, event, want, got)
+				}
+			}
+		}
+	}
+}
+
+// rendezvousPrinter is a printer whose labelTable, which is called while automata are being built, waits
+// until it's being called on two goroutines at once, or until a deadline passes, and records which happened
+type rendezvousPrinter struct {
+	nullPrinter
+	inside   atomic.Int32
+	met      chan struct{}
+	meetOnce sync.Once
+	deadline context.Context
+}
+
+func (p *rendezvousPrinter) labelTable(_ *smallTable, _ string) {
+	if p.inside.Add(1) >= 2 {
+		p.meetOnce.Do(func() { close(p.met) })
+	}
+	select {
+	case <-p.met:
+	case <-p.deadline.Done():
+	}
+	p.inside.Add(-1)
+}
+
+// TestAddPatternsBuildsConcurrently checks that addPatterns builds the automata for patterns which begin with
+// different fields on different goroutines; if it built them one at a time, the printer's calls would never
+// overlap
+func TestAddPatternsBuildsConcurrently(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(8))
+	deadline, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	printer := &rendezvousPrinter{met: make(chan struct{}), deadline: deadline}
+	var specs []PatternSpec
+	for i := 0; i < 8; i++ {
+		specs = append(specs, PatternSpec{X: i, Pattern: fmt.Sprintf(`{"f%d": [{"regexp": "x%d[a-c]+"}]}`, i, i)})
+	}
+	m := newCoreMatcher()
+	if err := m.addPatternsWithPrinter(specs, printer, BuiltForSpeed); err != nil {
+		t.Fatal(err.Error())
+	}
+	select {
+	case <-printer.met:
+	default:
+		t.Error("automata weren't built concurrently")
+	}
+	for i := 0; i < 8; i++ {
+		matches, err := m.matchesForFields([]Field{{Path: []byte(fmt.Sprintf("f%d", i)), Val: []byte(fmt.Sprintf(`"x%dab"`, i))}}, newNfaBuffers())
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !sameXs(matches, []X{i}) {
+			t.Errorf("f%d: matched %v", i, matches)
+		}
+	}
+}
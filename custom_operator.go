@@ -0,0 +1,183 @@
+package quamina
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Operator describes an application-defined Extended Pattern type, which is made available to all Quamina
+// instances by calling RegisterOperator. Exactly one of Predicate and Automaton must be provided.
+//
+// Predicate is called with the value of each string-valued Event field that reaches a point in the matcher
+// where a pattern using the operator applies. The string is presented with its escapes processed and without
+// its enclosing quote marks, so "US" is presented as the two bytes US. It returns true if the value matches.
+// Numbers, true, false, and null never match a predicate operator, and it isn't called for them.
+// Predicate must be safe to call concurrently from multiple goroutines. Patterns using a predicate operator
+// must provide the value true, for example {"luhn-valid": true}. Predicates are evaluated in addition to, not
+// as part of, Quamina's automata, so each adds a little to the cost of matching every value that reaches it.
+//
+// Automaton is called when a pattern using the operator is added, with the operator's argument in the pattern,
+// which may be any JSON value, as raw JSON text. It returns a regular expression in the dialect described in
+// REGEXP.md, which Quamina compiles into an automaton and merges with the others. Thus, automaton operators are
+// matched with the same efficiency as Quamina's built-in patterns.
+type Operator struct {
+	Predicate func(val []byte) bool
+	Automaton func(arg []byte) (string, error)
+}
+
+// builtInOperators lists the Extended Pattern types which are handled by readSpecialPattern and thus can't
+// be used for custom operators.
+var builtInOperators = map[string]bool{
+	"anything-but":       true,
+	"exists":             true,
+	"shellstyle":         true,
+	"wildcard":           true,
+	"prefix":             true,
+	"equals-ignore-case": true,
+	"regexp":             true,
+	"equals-field":       true,
+	"is-null":            true,
+	"is-missing":         true,
+	"sample":             true,
+	"in-set":             true,
+	"glob":               true,
+	"regexp-std":         true,
+}
+
+var (
+	customOperators     = make(map[string]Operator)
+	customOperatorsLock sync.RWMutex
+)
+
+// RegisterOperator makes the operator available, under the provided name, for use in Patterns added to any
+// Quamina instance. An error is returned if the name is already registered or is that of one of Quamina's
+// built-in pattern types, or if the Operator doesn't provide exactly one of Predicate and Automaton.
+// Operators can't be unregistered. RegisterOperator is safe to call concurrently, but it is expected that
+// operators will be registered before adding any Patterns that use them.
+func RegisterOperator(name string, op Operator) error {
+	if name == "" {
+		return errors.New("operator name must not be empty")
+	}
+	if (op.Predicate == nil) == (op.Automaton == nil) {
+		return fmt.Errorf("operator %q must provide exactly one of Predicate and Automaton", name)
+	}
+	if builtInOperators[name] {
+		return fmt.Errorf("%q is a built-in pattern type", name)
+	}
+	customOperatorsLock.Lock()
+	defer customOperatorsLock.Unlock()
+	if _, ok := customOperators[name]; ok {
+		return fmt.Errorf("operator %q is already registered", name)
+	}
+	customOperators[name] = op
+	return nil
+}
+
+func lookupOperator(name string) (Operator, bool) {
+	customOperatorsLock.RLock()
+	defer customOperatorsLock.RUnlock()
+	op, ok := customOperators[name]
+	return op, ok
+}
+
+// readCustomOperatorSpecial reads the argument of a custom operator and produces the corresponding typedVal;
+// a regexp for automaton operators, or a predicate.
+func readCustomOperatorSpecial(pb *patternBuild, name string, op Operator, valsIn []typedVal) (pathVals []typedVal, err error) {
+	pathVals = valsIn
+	var arg json.RawMessage
+	err = pb.jd.Decode(&arg)
+	if err != nil {
+		err = fmt.Errorf("value for %q malformed: %w", name, err)
+		return
+	}
+
+	if op.Predicate != nil {
+		if string(arg) != "true" {
+			err = fmt.Errorf("value for %q must be true", name)
+			return
+		}
+		pathVals = append(pathVals, typedVal{vType: predicateType, val: name})
+	} else {
+		var regexpString string
+		regexpString, err = op.Automaton(arg)
+		if err != nil {
+			err = fmt.Errorf("operator %q: %w", name, err)
+			return
+		}
+		parse := newRxParseState([]byte(regexpString))
+		parse.quantifierLimit = pb.opts.regexpQuantifierLimit()
+		_, err = readRegexpWithParse(parse)
+		if err != nil {
+			err = fmt.Errorf("operator %q produced invalid regexp: %w", name, err)
+			return
+		}
+		pathVals = append(pathVals, typedVal{vType: regexpType, parsedRegexp: parse.tree})
+	}
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
+
+// predicateTransition is a valueMatcher transition that is taken if the predicate returns true on the value.
+// It is used for custom predicate operators, in which case name identifies the operator, for samples, in
+// which case name is the bucket range, and for value sets, in which case name is that of the set. Patterns
+// with the same vType and name share the transition.
+type predicateTransition struct {
+	vType     valType
+	name      string
+	predicate func([]byte) bool
+	next      *fieldMatcher
+}
+
+// predicateFor returns the predicate function for a predicateTransition's vType and name, or false if there
+// is no such value set or operator
+func predicateFor(vType valType, name string) (func([]byte) bool, bool) {
+	switch vType {
+	case sampleType:
+		return makeSamplePredicate(name), true
+	case valueSetType:
+		set, ok := lookupValueSet(name)
+		if !ok {
+			return nil, false
+		}
+		return set.contains, true
+	default:
+		op, ok := lookupOperator(name)
+		if !ok || op.Predicate == nil {
+			return nil, false
+		}
+		return stringPredicate(op.Predicate), true
+	}
+}
+
+// stringPredicate returns a predicate which calls an Operator's Predicate only for string values, stripped of
+// their enclosing quote marks, as its documentation promises; other values don't match
+func stringPredicate(predicate func([]byte) bool) func([]byte) bool {
+	return func(val []byte) bool {
+		if len(val) < 2 || val[0] != '"' || val[len(val)-1] != '"' {
+			return false
+		}
+		return predicate(val[1 : len(val)-1])
+	}
+}
+
+// addPredicateTransition adds a predicate transition to vmFields, or finds the existing one for the same operator,
+// and returns the fieldMatcher it leads to.
+func (fields *vmFields) addPredicateTransition(val typedVal) *fieldMatcher {
+	for _, p := range fields.predicates {
+		if p.vType == val.vType && p.name == val.val {
+			return p.next
+		}
+	}
+	// value sets and operators can't be removed, so it's still there
+	predicate, _ := predicateFor(val.vType, val.val)
+	// copy rather than append in place, because concurrent readers may be looking at the old slice
+	predicates := make([]predicateTransition, len(fields.predicates), len(fields.predicates)+1)
+	copy(predicates, fields.predicates)
+	next := newFieldMatcher()
+	fields.predicates = append(predicates, predicateTransition{vType: val.vType, name: val.val, predicate: predicate, next: next})
+	return next
+}
@@ -0,0 +1,183 @@
+package quamina
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// luhnValid checks the Luhn checksum of a string of digits
+func luhnValid(digits []byte) bool {
+	if len(digits) == 0 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// oneOf takes a JSON array of strings and builds a regexp matching any of them
+func oneOf(arg []byte) (string, error) {
+	var choices []string
+	if err := json.Unmarshal(arg, &choices); err != nil {
+		return "", err
+	}
+	return "(" + strings.Join(choices, "|") + ")", nil
+}
+
+// isoCountry checks for one of a few ISO 3166 country codes
+func isoCountry(val []byte) bool {
+	switch string(val) {
+	case "US", "FR", "DE":
+		return true
+	}
+	return false
+}
+
+// string values are given to predicates without their quote marks
+func TestCustomOperatorStringPredicate(t *testing.T) {
+	if err := RegisterOperator("test-iso-country", Operator{Predicate: isoCountry}); err != nil {
+		t.Fatal(err.Error())
+	}
+	events := []struct {
+		event string
+		match bool
+	}{
+		{`{"origin": "US"}`, true},
+		{`{"origin": "\u0046R"}`, true},
+		{`{"origin": "XX"}`, false},
+		{`{"origin": "\"US\""}`, false},
+		{`{"origin": ["XX", "DE"]}`, true},
+		{`{"origin": 1}`, false},
+		{`{"origin": true}`, false},
+		{`{"origin": null}`, false},
+	}
+	for _, frozen := range []bool{false, true} {
+		q, _ := New()
+		if err := q.AddPattern("p", `{"origin": [{"test-iso-country": true}]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if frozen {
+			q.Freeze()
+		}
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if (len(matches) == 1) != e.match {
+				t.Errorf("frozen %v, %s: matched %v", frozen, e.event, matches)
+			}
+		}
+	}
+}
+
+func TestCustomOperators(t *testing.T) {
+	if err := RegisterOperator("test-luhn", Operator{Predicate: luhnValid}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := RegisterOperator("test-one-of", Operator{Automaton: oneOf}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{"luhn", `{"card": [{"test-luhn": true}]}`},
+		{"luhnOrX", `{"card": ["x", {"test-luhn": true}]}`},
+		{"literal", `{"card": ["79927398713"]}`},
+		{"country", `{"country": [{"test-one-of": ["FR", "DE", "IT"]}]}`},
+		{"countryAndLuhn", `{"country": [{"test-one-of": ["DE"]}], "card": [{"test-luhn": true}]}`},
+	}
+	events := []struct {
+		event   string
+		matches []string
+	}{
+		{`{"card": "79927398713"}`, []string{"luhn", "luhnOrX", "literal"}},
+		{`{"card": "79927398710"}`, []string{}},
+		{`{"card": "x"}`, []string{"luhnOrX"}},
+		{`{"card": 79927398713}`, []string{}},
+		{`{"country": "DE"}`, []string{"country"}},
+		{`{"country": "US"}`, []string{}},
+		{`{"country": "DE", "card": "4539578763621486"}`, []string{"country", "countryAndLuhn", "luhn", "luhnOrX"}},
+	}
+
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		q, _ := New()
+		_ = q.SetMatcherBuildMode(mode)
+		for _, p := range patterns {
+			if err := q.AddPattern(p.name, p.pattern); err != nil {
+				t.Fatalf("AddPattern %s: %s", p.name, err.Error())
+			}
+		}
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if len(matches) != len(e.matches) {
+				t.Errorf("%s: wanted %v got %v", e.event, e.matches, matches)
+				continue
+			}
+			for _, want := range e.matches {
+				if !containsX(matches, want) {
+					t.Errorf("%s: wanted %v got %v", e.event, e.matches, matches)
+				}
+			}
+		}
+	}
+
+	bad := []string{
+		`{"card": [{"test-luhn": false}]}`,
+		`{"card": [{"test-luhn": "yes"}]}`,
+		`{"country": [{"test-one-of": "DE"}]}`,
+		`{"country": [{"test-one-of": ["(DE"]}]}`,
+		`{"country": [{"test-unregistered": true}]}`,
+	}
+	for _, b := range bad {
+		if _, err := patternFromJSON([]byte(b)); err == nil {
+			t.Errorf("accepted %s", b)
+		}
+	}
+}
+
+func TestRegisterOperatorErrors(t *testing.T) {
+	pred := func([]byte) bool { return true }
+	auto := func([]byte) (string, error) { return "a", nil }
+	bad := []struct {
+		name string
+		op   Operator
+	}{
+		{"", Operator{Predicate: pred}},
+		{"test-neither", Operator{}},
+		{"test-both", Operator{Predicate: pred, Automaton: auto}},
+		{"prefix", Operator{Predicate: pred}},
+		{"equals-field", Operator{Automaton: auto}},
+	}
+	for _, b := range bad {
+		if err := RegisterOperator(b.name, b.op); err == nil {
+			t.Errorf("registered %q", b.name)
+		}
+	}
+	if err := RegisterOperator("test-twice", Operator{Predicate: pred}); err != nil {
+		t.Error(err.Error())
+	}
+	if err := RegisterOperator("test-twice", Operator{Predicate: pred}); err == nil {
+		t.Error("registered test-twice twice")
+	}
+}
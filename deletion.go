@@ -0,0 +1,571 @@
+package quamina
+
+import "slices"
+
+// Deleting patterns means undoing what addPattern did to the automaton, which it doesn't record: the end
+// states a pattern's X was added to, and the transitions that lead to them, are shared with other patterns
+// and the values that led to them are compiled into automata. So when deletion is enabled, coreMatcher keeps
+// a deletionIndex alongside the automaton, which records for each X the fieldMatchers whose matches
+// include it, for each fieldMatcher the transition which leads to it, and for each valueMatcher the values
+// which have been added to it.
+// Deleting an X removes it from the matches of its end states. A fieldMatcher with no matches and no
+// transitions can't contribute to any match, so it is unlinked from the fieldMatcher which leads to it, which
+// may in turn be left empty, and so on up toward the start state. Exists and equals-field transitions, the
+// singleton transition, and predicates are easily removed. A value in an automaton can't be, so it is left
+// in place, leading to the empty fieldMatcher, and counted as dead; once the dead values are as many as the
+// live ones, the valueMatcher is rebuilt from the live values, which keeps the cost of deletion proportional
+// to the size of the pattern when averaged over many deletions. A rebuilt valueMatcher with one string
+// value gets its singleton transition back.
+// Like addPattern, deletion holds the coreMatcher's lock, and updates the automaton with atomic stores,
+// so matchesForFields can proceed while it works.
+// The frozen automaton (see frozen_matcher.go) can't be changed, and the deletionIndex doesn't cover it. So
+// when an X which has patterns in the frozen automaton is deleted, it's recorded in coreFields.deleted,
+// and removed from the frozen automaton's results until the next freeze, which drops its patterns.
+
+// deletionIndex records what deletePatterns needs to know about the automaton; see above. Its maps are
+// only accessed with the coreMatcher's lock held.
+// ends records, for each X, the fieldMatchers whose matches include it, or an exclusionX wrapping it.
+// parents records the transition which leads to each fieldMatcher other than the start state.
+// values records the values which have been added to each valueMatcher.
+// frozenXs is the set of X values which have patterns in the frozen automaton.
+// buildMode is the mode of the build in progress, which is recorded for each valueMatcher it changes.
+type deletionIndex struct {
+	ends      map[X]map[*fieldMatcher]bool
+	parents   map[*fieldMatcher]fmParent
+	values    map[*valueMatcher]*vmValues
+	frozenXs  map[X]bool
+	buildMode MatcherBuildMode
+}
+
+// transitionKind says which kind of transition leads to a fieldMatcher
+type transitionKind int
+
+const (
+	valueTransition transitionKind = iota
+	existsTrueTransition
+	existsFalseTransition
+	existsFalseIgnoringNullTransition
+	equalsFieldTransition
+)
+
+// fmParent is the transition which leads to a fieldMatcher: from the fieldMatcher from, on the field path,
+// through the valueMatcher vm if it's a value transition, or to the field refPath if it's an equals-field
+// transition
+type fmParent struct {
+	from    *fieldMatcher
+	kind    transitionKind
+	path    string
+	refPath string
+	vm      *valueMatcher
+}
+
+// vmValues records the values which have been added to a valueMatcher, keyed by the fieldMatcher each
+// leads to. order lists those fieldMatchers in the order the values were added, and may still list some
+// whose values have been deleted. predicates counts the values which are predicates, and dead the values
+// which have been deleted but are still in the automaton or literalDAWG. buildMode is the mode the
+// valueMatcher was last built in.
+type vmValues struct {
+	values     map[*fieldMatcher]typedVal
+	order      []*fieldMatcher
+	predicates int
+	dead       int
+	buildMode  MatcherBuildMode
+}
+
+func newDeletionIndex() *deletionIndex {
+	return &deletionIndex{
+		ends:     make(map[X]map[*fieldMatcher]bool),
+		parents:  make(map[*fieldMatcher]fmParent),
+		values:   make(map[*valueMatcher]*vmValues),
+		frozenXs: make(map[X]bool),
+	}
+}
+
+// baseX returns the X value an exclusion pattern's X wraps, or x if it isn't one
+func baseX(x X) X {
+	if ex, ok := x.(exclusionX); ok {
+		return ex.x
+	}
+	return x
+}
+
+// reset forgets the automaton, which has been replaced by fz, and records the X values in fz
+func (idx *deletionIndex) reset(fz *frozenMatcher) {
+	clear(idx.ends)
+	clear(idx.parents)
+	clear(idx.values)
+	clear(idx.frozenXs)
+	if fz != nil {
+		for _, x := range fz.xs {
+			idx.frozenXs[baseX(x)] = true
+		}
+	}
+}
+
+// noteTransitions records the transitions from the fieldMatcher from which addPatternFields has followed for
+// field, which led to nexts
+func (idx *deletionIndex) noteTransitions(from *fieldMatcher, field *patternField, nexts []*fieldMatcher) {
+	parent := fmParent{from: from, kind: transitionKindOf(field), path: field.path}
+	switch parent.kind {
+	case equalsFieldTransition:
+		parent.refPath = field.vals[0].val
+	case valueTransition:
+		parent.vm = from.fields().transitions[field.path]
+	}
+	var values *vmValues
+	if parent.kind == valueTransition {
+		values = idx.values[parent.vm]
+		if values == nil {
+			values = &vmValues{values: make(map[*fieldMatcher]typedVal)}
+			idx.values[parent.vm] = values
+		}
+		values.buildMode = idx.buildMode
+	}
+	for i, next := range nexts {
+		// an existing transition, for example a repeated string value
+		if _, ok := idx.parents[next]; ok {
+			continue
+		}
+		idx.parents[next] = parent
+		if values != nil {
+			values.add(field.vals[i], next)
+		}
+	}
+}
+
+// transitionKindOf returns the kind of transition which addPatternFields follows for field
+func transitionKindOf(field *patternField) transitionKind {
+	switch field.vals[0].vType {
+	case existsTrueType:
+		return existsTrueTransition
+	case existsFalseType:
+		return existsFalseTransition
+	case existsFalseIgnoringNullType:
+		return existsFalseIgnoringNullTransition
+	case equalsFieldType:
+		return equalsFieldTransition
+	default:
+		return valueTransition
+	}
+}
+
+func (values *vmValues) add(val typedVal, next *fieldMatcher) {
+	if _, ok := values.values[next]; !ok {
+		values.order = append(values.order, next)
+	}
+	values.values[next] = val
+	if isPredicateType(val.vType) {
+		values.predicates++
+	}
+}
+
+// live returns the fieldMatchers whose values haven't been deleted, in the order the values were added,
+// and forgets those which have
+func (values *vmValues) live() []*fieldMatcher {
+	values.order = slices.DeleteFunc(values.order, func(next *fieldMatcher) bool {
+		_, ok := values.values[next]
+		return !ok
+	})
+	return values.order
+}
+
+// inAutomaton returns the number of live values which are in vm's automaton or literalDAWG
+func (values *vmValues) inAutomaton(fields *vmFields) int {
+	n := len(values.values) - values.predicates
+	if fields.singletonTransition != nil {
+		n--
+	}
+	return n
+}
+
+func isPredicateType(vType valType) bool {
+	return vType == predicateType || vType == sampleType || vType == valueSetType
+}
+
+// noteEnd records that x has been added to the matches of fm
+func (idx *deletionIndex) noteEnd(x X, fm *fieldMatcher) {
+	base := baseX(x)
+	ends := idx.ends[base]
+	if ends == nil {
+		ends = make(map[*fieldMatcher]bool)
+		idx.ends[base] = ends
+	}
+	ends[fm] = true
+}
+
+// deletePatterns removes x and any exclusionX wrapping it from the automaton beginning at start, and
+// unlinks the fieldMatchers which are left empty
+func (idx *deletionIndex) deletePatterns(start *fieldMatcher, x X, bufs *closureBuffers) {
+	ends := idx.ends[x]
+	delete(idx.ends, x)
+	for fm := range ends {
+		current := fm.fields()
+		fresh := *current // struct copy
+		fresh.matches = make([]X, 0, len(current.matches))
+		for _, match := range current.matches {
+			if baseX(match) != x {
+				fresh.matches = append(fresh.matches, match)
+			}
+		}
+		fm.update(&fresh)
+	}
+	for fm := range ends {
+		idx.prune(start, fm, bufs)
+	}
+}
+
+// prune unlinks fm if it's empty, and then the fieldMatcher which led to it if that's left empty, and so on
+func (idx *deletionIndex) prune(start, fm *fieldMatcher, bufs *closureBuffers) {
+	for fm != start && fm.isEmpty() {
+		parent, ok := idx.parents[fm]
+		if !ok {
+			// already unlinked along with another end state
+			return
+		}
+		delete(idx.parents, fm)
+		idx.unlink(fm, parent, bufs)
+		fm = parent.from
+	}
+}
+
+// unlink removes the transition described by parent, which leads to fm
+func (idx *deletionIndex) unlink(fm *fieldMatcher, parent fmParent, bufs *closureBuffers) {
+	current := parent.from.fields()
+	fresh := *current // struct copy
+	switch parent.kind {
+	case existsTrueTransition:
+		fresh.existsTrue = withoutKey(current.existsTrue, parent.path)
+	case existsFalseTransition:
+		fresh.existsFalse = withoutKey(current.existsFalse, parent.path)
+	case existsFalseIgnoringNullTransition:
+		fresh.existsFalseIgnoringNull = withoutKey(current.existsFalseIgnoringNull, parent.path)
+	case equalsFieldTransition:
+		fresh.equalsField = withoutKey(current.equalsField, parent.path)
+		if refs := withoutKey(current.equalsField[parent.path], parent.refPath); len(refs) > 0 {
+			fresh.equalsField[parent.path] = refs
+		}
+	case valueTransition:
+		if !idx.removeValue(parent.vm, fm, bufs) {
+			return
+		}
+		fresh.transitions = withoutKey(current.transitions, parent.path)
+	}
+	parent.from.update(&fresh)
+}
+
+// withoutKey returns a copy of m without key
+func withoutKey[V any](m map[string]V, key string) map[string]V {
+	fresh := make(map[string]V, len(m))
+	for k, v := range m {
+		if k != key {
+			fresh[k] = v
+		}
+	}
+	return fresh
+}
+
+// removeValue removes the value which leads to next from vm, and returns true if vm is left with no values
+func (idx *deletionIndex) removeValue(vm *valueMatcher, next *fieldMatcher, bufs *closureBuffers) bool {
+	values := idx.values[vm]
+	val := values.values[next]
+	delete(values.values, next)
+	if len(values.values) == 0 {
+		delete(idx.values, vm)
+		return true
+	}
+
+	fields := vm.getFieldsForUpdate()
+	switch {
+	case fields.singletonTransition == next:
+		fields.singletonMatch, fields.singletonTransition = nil, nil
+	case isPredicateType(val.vType):
+		values.predicates--
+		predicates := make([]predicateTransition, 0, len(fields.predicates))
+		for _, p := range fields.predicates {
+			if p.next != next {
+				predicates = append(predicates, p)
+			}
+		}
+		fields.predicates = predicates
+	default:
+		values.dead++
+		if values.dead >= values.inAutomaton(fields) {
+			values.rebuild(vm, bufs)
+		}
+		return false
+	}
+	vm.update(fields)
+	return false
+}
+
+// rebuild replaces vm's automaton with one built from the values which remain, which are added in the
+// order they were first added, one at a time as addTransition would, so the result doesn't depend on the
+// order of a map. The builders make a new fieldMatcher for each value, so the fieldMatcher recorded in values
+// is substituted for the new one before each value's automaton is merged in.
+func (values *vmValues) rebuild(vm *valueMatcher, bufs *closureBuffers) {
+	current := vm.fields()
+	fresh := &vmFields{
+		numericStrings: current.numericStrings,
+		exactIntegers:  current.exactIntegers,
+	}
+	var inAutomaton []*fieldMatcher
+	for _, next := range values.live() {
+		val := values.values[next]
+		if isPredicateType(val.vType) {
+			predicate, _ := predicateFor(val.vType, val.val)
+			fresh.predicates = append(fresh.predicates, predicateTransition{vType: val.vType, name: val.val, predicate: predicate, next: next})
+		} else {
+			inAutomaton = append(inAutomaton, next)
+		}
+	}
+
+	if len(inAutomaton) == 1 {
+		if val := values.values[inAutomaton[0]]; val.vType == stringType || val.vType == literalType {
+			fresh.singletonMatch = []byte(val.val)
+			fresh.singletonTransition = inAutomaton[0]
+			inAutomaton = nil
+		}
+	}
+	values.dead = 0
+
+	bufs.reset()
+	for _, next := range inAutomaton {
+		newFA, newNext := fresh.makeValueFA(values.values[next], sharedNullPrinter)
+		replaceFieldTransitions(newFA, map[*fieldMatcher]*fieldMatcher{newNext: next})
+		if fresh.start == nil {
+			fresh.start = newFA
+		} else {
+			fresh.start = mergeStartStates(fresh.start, newFA, sharedNullPrinter)
+		}
+		fresh.prepareAutomaton(bufs, values.buildMode)
+	}
+	vm.update(fresh)
+}
+
+// replaceFieldTransitions replaces the fieldMatchers which are keys in replacements with their values
+// throughout the automaton beginning at start, which must not be in use
+func replaceFieldTransitions(start *faState, replacements map[*fieldMatcher]*fieldMatcher) {
+	seen := map[*faState]bool{start: true}
+	todo := []*faState{start}
+	for len(todo) > 0 {
+		state := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+		for i, fm := range state.fieldTransitions {
+			if replacement, ok := replacements[fm]; ok {
+				state.fieldTransitions[i] = replacement
+			}
+		}
+		for _, steps := range [][]*faState{state.table.steps, state.table.epsilons} {
+			for _, next := range steps {
+				if next != nil && !seen[next] {
+					seen[next] = true
+					todo = append(todo, next)
+				}
+			}
+		}
+	}
+}
+
+// mergeLog records the fieldMatchers and valueMatchers which mergeFieldMatchers has replaced with merged
+// ones, so that the deletionIndex can be updated to match. Children are recorded before their parents.
+type mergeLog struct {
+	fieldMatchers []mergedFieldMatcher
+	valueMatchers []mergedValueMatcher
+}
+
+type mergedFieldMatcher struct {
+	from1, from2, to *fieldMatcher
+}
+
+type mergedValueMatcher struct {
+	from1, from2, to *valueMatcher
+}
+
+func (log *mergeLog) fieldMatcher(from1, from2, to *fieldMatcher) {
+	if log != nil {
+		log.fieldMatchers = append(log.fieldMatchers, mergedFieldMatcher{from1, from2, to})
+	}
+}
+
+func (log *mergeLog) valueMatcher(from1, from2, to *valueMatcher) {
+	if log != nil {
+		log.valueMatchers = append(log.valueMatchers, mergedValueMatcher{from1, from2, to})
+	}
+}
+
+// add appends the merges recorded in other to log
+func (log *mergeLog) add(other *mergeLog) {
+	log.fieldMatchers = append(log.fieldMatchers, other.fieldMatchers...)
+	log.valueMatchers = append(log.valueMatchers, other.valueMatchers...)
+}
+
+// absorb adds to idx the records in other, which was kept while a separate automaton was built
+func (idx *deletionIndex) absorb(other *deletionIndex) {
+	for x, ends := range other.ends {
+		for fm := range ends {
+			idx.noteEnd(x, fm)
+		}
+	}
+	for fm, parent := range other.parents {
+		idx.parents[fm] = parent
+	}
+	for vm, values := range other.values {
+		idx.values[vm] = values
+	}
+}
+
+// rebase updates the index after the merge recorded in log
+func (idx *deletionIndex) rebase(log *mergeLog) {
+	fms := make(map[*fieldMatcher]*fieldMatcher, 2*len(log.fieldMatchers))
+	for _, merged := range log.fieldMatchers {
+		fms[merged.from1], fms[merged.from2] = merged.to, merged.to
+	}
+	remap := func(fm *fieldMatcher) *fieldMatcher {
+		if to, ok := fms[fm]; ok {
+			return to
+		}
+		return fm
+	}
+
+	// merging two valueMatchers with the same singleton merges the fieldMatchers it leads to, so values can
+	// be duplicated
+	for _, merged := range log.valueMatchers {
+		values := &vmValues{values: make(map[*fieldMatcher]typedVal), buildMode: idx.buildMode}
+		for _, from := range []*valueMatcher{merged.from1, merged.from2} {
+			fromValues := idx.values[from]
+			if fromValues == nil {
+				continue
+			}
+			delete(idx.values, from)
+			for _, next := range fromValues.live() {
+				if _, ok := values.values[remap(next)]; !ok {
+					values.add(fromValues.values[next], remap(next))
+				}
+			}
+			values.dead += fromValues.dead
+		}
+		idx.values[merged.to] = values
+	}
+
+	for _, merged := range log.fieldMatchers {
+		parent, ok := idx.parents[merged.from1]
+		if !ok {
+			parent, ok = idx.parents[merged.from2]
+		}
+		delete(idx.parents, merged.from1)
+		delete(idx.parents, merged.from2)
+		if ok {
+			idx.parents[merged.to] = parent
+		}
+
+		fields := merged.to.fields()
+		for _, x := range fields.matches {
+			ends := idx.ends[baseX(x)]
+			delete(ends, merged.from1)
+			delete(ends, merged.from2)
+			idx.noteEnd(x, merged.to)
+		}
+
+		// The transitions out of the merged fieldMatcher now come from it, some through merged valueMatchers.
+		// Its parent is rebased in the same way, because a fieldMatcher is only merged when its parent is,
+		// and the log records it first.
+		for path, vm := range fields.transitions {
+			if values := idx.values[vm]; values != nil {
+				for next := range values.values {
+					idx.parents[next] = fmParent{from: merged.to, kind: valueTransition, path: path, vm: vm}
+				}
+			}
+		}
+		for kind, transitions := range map[transitionKind]map[string]*fieldMatcher{
+			existsTrueTransition:              fields.existsTrue,
+			existsFalseTransition:             fields.existsFalse,
+			existsFalseIgnoringNullTransition: fields.existsFalseIgnoringNull,
+		} {
+			for path, next := range transitions {
+				idx.parents[next] = fmParent{from: merged.to, kind: kind, path: path}
+			}
+		}
+		for path, refs := range fields.equalsField {
+			for refPath, next := range refs {
+				idx.parents[next] = fmParent{from: merged.to, kind: equalsFieldTransition, path: path, refPath: refPath}
+			}
+		}
+	}
+}
+
+// withoutDeleted removes the patterns for the X values in deleted from the automaton beginning at fm, which
+// must not be in use, and returns true if it's left empty. It's used to apply deletions to a thawed
+// frozenMatcher. Transitions to fieldMatchers which are left empty are removed, except for those in
+// automata.
+func withoutDeleted(fm *fieldMatcher, deleted map[X]bool, empty map[*fieldMatcher]bool) bool {
+	if isEmpty, ok := empty[fm]; ok {
+		return isEmpty
+	}
+	// in case of a cycle, which there can't be
+	empty[fm] = false
+	fields := fm.fields()
+	matches := fields.matches[:0]
+	for _, x := range fields.matches {
+		if !deleted[baseX(x)] {
+			matches = append(matches, x)
+		}
+	}
+	fields.matches = matches
+
+	for _, transitions := range []map[string]*fieldMatcher{fields.existsTrue, fields.existsFalse, fields.existsFalseIgnoringNull} {
+		for path, next := range transitions {
+			if withoutDeleted(next, deleted, empty) {
+				delete(transitions, path)
+			}
+		}
+	}
+	for path, refs := range fields.equalsField {
+		for refPath, next := range refs {
+			if withoutDeleted(next, deleted, empty) {
+				delete(refs, refPath)
+			}
+		}
+		if len(refs) == 0 {
+			delete(fields.equalsField, path)
+		}
+	}
+	for path, vm := range fields.transitions {
+		vmFields := vm.fields()
+		if vmFields.singletonTransition != nil && withoutDeleted(vmFields.singletonTransition, deleted, empty) {
+			vmFields.singletonMatch, vmFields.singletonTransition = nil, nil
+		}
+		predicates := vmFields.predicates[:0]
+		for _, p := range vmFields.predicates {
+			if !withoutDeleted(p.next, deleted, empty) {
+				predicates = append(predicates, p)
+			}
+		}
+		vmFields.predicates = predicates
+		if vmFields.start != nil {
+			for _, next := range reachableFieldTransitions(vmFields.start) {
+				withoutDeleted(next, deleted, empty)
+			}
+		}
+		if vmFields.literals != nil {
+			for _, transitions := range vmFields.literals.transitions {
+				for _, next := range transitions {
+					withoutDeleted(next, deleted, empty)
+				}
+			}
+		}
+		if vmFields.singletonTransition == nil && vmFields.start == nil && vmFields.literals == nil && len(vmFields.predicates) == 0 {
+			delete(fields.transitions, path)
+		}
+	}
+	empty[fm] = fm.isEmpty()
+	return empty[fm]
+}
+
+// dropDeleted removes from matches the X values in deleted, and exclusionX values wrapping them
+func dropDeleted(matches *matchSet, deleted map[X]bool) {
+	for x := range matches.set {
+		if deleted[baseX(x)] {
+			delete(matches.set, x)
+		}
+	}
+}
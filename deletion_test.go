@@ -0,0 +1,495 @@
+package quamina
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// deletionTestPattern is a pattern, the X it's added for, and whether it's an exclusion pattern
+type deletionTestPattern struct {
+	x         X
+	pattern   string
+	exclusion bool
+}
+
+func deletionTestPatterns() []deletionTestPattern {
+	var patterns []deletionTestPattern
+	for i, pattern := range frozenTestPatterns() {
+		patterns = append(patterns, deletionTestPattern{x: i % 12, pattern: pattern})
+	}
+	// the same patterns for other Xs, so that deleting one leaves the transitions in place for the other
+	for i, pattern := range frozenTestPatterns()[:13] {
+		patterns = append(patterns, deletionTestPattern{x: fmt.Sprintf("again%d", i%5), pattern: pattern})
+	}
+	patterns = append(patterns,
+		deletionTestPattern{x: 0, pattern: `{"b": ["azb"]}`, exclusion: true},
+		deletionTestPattern{x: 3, pattern: `{"n": [1000]}`, exclusion: true},
+		deletionTestPattern{x: "again1", pattern: `{"s": [{"prefix": "s3"}]}`, exclusion: true},
+	)
+	return patterns
+}
+
+func addDeletionTestPatterns(t *testing.T, q *Quamina, patterns []deletionTestPattern, bulk bool) {
+	t.Helper()
+	var specs []PatternSpec
+	for _, p := range patterns {
+		var err error
+		switch {
+		case p.exclusion:
+			err = q.AddExclusionPattern(p.x, p.pattern)
+		case bulk:
+			specs = append(specs, PatternSpec{X: p.x, Pattern: p.pattern})
+		default:
+			err = q.AddPattern(p.x, p.pattern)
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", p.pattern, err.Error())
+		}
+	}
+	if err := q.AddPatterns(specs); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestDeletePatterns(t *testing.T) {
+	events := frozenTestEvents()
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		for _, variant := range []string{"added", "bulk", "compacted", "frozen"} {
+			patterns := deletionTestPatterns()
+			q, _ := New(WithPatternDeletion(true))
+			_ = q.SetMatcherBuildMode(mode)
+			half := len(patterns) / 2
+			addDeletionTestPatterns(t, q, patterns[:half], variant == "bulk")
+			switch variant {
+			case "compacted":
+				q.Compact()
+			case "frozen":
+				q.Freeze()
+			}
+			addDeletionTestPatterns(t, q, patterns[half:], variant == "bulk")
+
+			live := make(map[X]bool)
+			for _, p := range patterns {
+				live[p.x] = true
+			}
+			check := func(when string) {
+				t.Helper()
+				reference, _ := New()
+				_ = reference.SetMatcherBuildMode(mode)
+				var livePatterns []deletionTestPattern
+				for _, p := range patterns {
+					if live[p.x] {
+						livePatterns = append(livePatterns, p)
+					}
+				}
+				addDeletionTestPatterns(t, reference, livePatterns, false)
+				for _, event := range events {
+					want, _ := reference.MatchesForEvent([]byte(event))
+					got, err := q.MatchesForEvent([]byte(event))
+					if err != nil {
+						t.Fatal(err.Error())
+					}
+					if !sameXs(want, got) {
+						t.Errorf("mode %d, %s, %s, %s: wanted %v got %v", mode, variant, when, event, want, got)
+					}
+				}
+			}
+
+			rng := rand.New(rand.NewSource(int64(mode)))
+			var xs []X
+			for x := range live {
+				xs = append(xs, x)
+			}
+			rng.Shuffle(len(xs), func(i, j int) { xs[i], xs[j] = xs[j], xs[i] })
+			for i, x := range xs {
+				if err := q.DeletePatterns(x); err != nil {
+					t.Fatal(err.Error())
+				}
+				live[x] = false
+				check(fmt.Sprintf("deleted %v", x))
+
+				// put some back
+				if i%4 == 0 {
+					var again []deletionTestPattern
+					for _, p := range patterns {
+						if p.x == x {
+							again = append(again, p)
+						}
+					}
+					addDeletionTestPatterns(t, q, again, variant == "bulk")
+					live[x] = true
+					check(fmt.Sprintf("re-added %v", x))
+				}
+				if variant == "frozen" && i == len(xs)/2 {
+					q.Freeze()
+					check("refrozen")
+				}
+			}
+			for _, x := range xs {
+				_ = q.DeletePatterns(x)
+			}
+			if variant != "frozen" && !q.matcher.(*coreMatcher).fields().state.isEmpty() {
+				t.Errorf("mode %d, %s: automaton not empty after deleting everything", mode, variant)
+			}
+		}
+	}
+}
+
+func TestDeletePatternsReclaims(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	empty := q.GetMatcherStats()["bytes"]
+	add := func(from, to int) {
+		for i := from; i < to; i++ {
+			pattern := fmt.Sprintf(`{"a": ["v%d"], "b": [{"shellstyle": "*%d*"}], "c": [{"exists": true}]}`, i, i)
+			if err := q.AddPattern(i, pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+	}
+	add(0, 500)
+	full := q.GetMatcherStats()["bytes"]
+	for i := 0; i < 500; i++ {
+		if err := q.DeletePatterns(i); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if after := q.GetMatcherStats()["bytes"]; after != empty {
+		t.Errorf("%.0f bytes empty, %.0f full, %.0f after deleting everything", empty, full, after)
+	}
+	if !q.matcher.(*coreMatcher).fields().state.isEmpty() {
+		t.Error("automaton not empty")
+	}
+	deletion := q.matcher.(*coreMatcher).deletion
+	if len(deletion.ends) != 0 || len(deletion.parents) != 0 || len(deletion.values) != 0 {
+		t.Errorf("index not empty: %d ends, %d parents, %d valueMatchers",
+			len(deletion.ends), len(deletion.parents), len(deletion.values))
+	}
+
+	// deleting half the patterns leaves the automaton a lot smaller
+	add(0, 500)
+	for i := 0; i < 500; i += 2 {
+		if err := q.DeletePatterns(i); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if half := q.GetMatcherStats()["bytes"]; half > 0.75*full {
+		t.Errorf("%.0f bytes full, %.0f after deleting half", full, half)
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"a": "v17", "b": "x17", "c": 1}`))
+	if !sameXs(matches, []X{17}) {
+		t.Errorf("matched %v", matches)
+	}
+}
+
+func TestDeletePatternsSingleton(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	for _, value := range []string{"a", "b", "c"} {
+		if err := q.AddPattern(value, fmt.Sprintf(`{"x": ["%s"]}`, value)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	vm := q.matcher.(*coreMatcher).fields().state.fields().transitions["x"]
+	if vm.fields().singletonMatch != nil {
+		t.Fatal("singleton with three values")
+	}
+	_ = q.DeletePatterns("a")
+	_ = q.DeletePatterns("c")
+	fields := vm.fields()
+	if string(fields.singletonMatch) != `"b"` || fields.start != nil {
+		t.Errorf("singleton not restored: %q", fields.singletonMatch)
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"x": "b"}`))
+	if !sameXs(matches, []X{"b"}) {
+		t.Errorf("matched %v", matches)
+	}
+}
+
+func TestDeletePatternsFrozen(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	for i := 0; i < 10; i++ {
+		if err := q.AddPattern(i, fmt.Sprintf(`{"x": ["%d"]}`, i%2)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	q.Freeze()
+	for i := 0; i < 10; i += 2 {
+		_ = q.DeletePatterns(i)
+	}
+	_ = q.AddPattern(4, `{"x": ["0"]}`)
+	check := func(when string) {
+		t.Helper()
+		matches, _ := q.MatchesForEvent([]byte(`{"x": "0"}`))
+		if !sameXs(matches, []X{4}) {
+			t.Errorf("%s: matched %v", when, matches)
+		}
+	}
+	check("deleted")
+	q.Freeze()
+	check("refrozen")
+	if len(q.matcher.(*coreMatcher).fields().frozen.xs) != 6 {
+		t.Errorf("deleted patterns weren't dropped from the frozen automaton")
+	}
+}
+
+func TestDeletePatternsConcurrently(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	for i := 0; i < 200; i++ {
+		if err := q.AddPattern(i, fmt.Sprintf(`{"a": [{"shellstyle": "*%d"}, "x%d"]}`, i%10, i)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		matcher := q.Copy()
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if _, err := matcher.MatchesForEvent([]byte(`{"a": "x17"}`)); err != nil {
+					t.Error(err.Error())
+				}
+			}
+		}()
+	}
+	for i := 0; i < 200; i++ {
+		if i != 17 {
+			_ = q.DeletePatterns(i)
+		}
+	}
+	close(done)
+	wg.Wait()
+	matches, _ := q.MatchesForEvent([]byte(`{"a": "x17"}`))
+	if !sameXs(matches, []X{17}) {
+		t.Errorf("matched %v", matches)
+	}
+}
+
+func TestDeletePatternsSameX(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	for _, pattern := range []string{`{"enjoys": ["queso"]}`, `{"needs": ["chips"]}`, `{"needs": ["chips"]}`} {
+		if err := q.AddPattern(1, pattern); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if err := q.AddPattern(2, `{"enjoys": ["queso"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	check := func(event string, want []X) {
+		t.Helper()
+		matches, err := q.MatchesForEvent([]byte(event))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !sameXs(matches, want) {
+			t.Errorf("%s: wanted %v got %v", event, want, matches)
+		}
+	}
+	check(`{"enjoys": "queso"}`, []X{1, 2})
+	check(`{"needs": "chips"}`, []X{1})
+	check(`{"enjoys": "queso", "needs": "chips"}`, []X{1, 2})
+
+	// deleting an X deletes all its patterns, and deleting it again is harmless
+	for i := 0; i < 2; i++ {
+		if err := q.DeletePatterns(1); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	check(`{"enjoys": "queso"}`, []X{2})
+	check(`{"needs": "chips"}`, nil)
+	if _, ok := q.matcher.(*coreMatcher).deletion.ends[1]; ok {
+		t.Error("deleted X still indexed")
+	}
+}
+
+func TestDeletePatternsBuildModes(t *testing.T) {
+	entries := []struct {
+		x       X
+		pattern string
+		mode    MatcherBuildMode
+	}{
+		{1, `{"x": [{"wildcard": "t*ortilla"}]}`, BuiltForComfort},
+		{2, `{"x": [{"wildcard": "tortilla*"}]}`, BuiltForSpeed},
+		{3, `{"x": [{"wildcard": "*tortilla"}]}`, BuiltLazily},
+		{4, `{"x": [{"wildcard": "tortil*la"}]}`, BuiltForSpeed},
+	}
+	q, _ := New(WithPatternDeletion(true))
+	for _, entry := range entries {
+		_ = q.SetMatcherBuildMode(entry.mode)
+		if err := q.AddPattern(entry.x, entry.pattern); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	event := []byte(`{"x": "tortilla"}`)
+	matches, _ := q.MatchesForEvent(event)
+	if !sameXs(matches, []X{1, 2, 3, 4}) {
+		t.Errorf("matched %v", matches)
+	}
+	// enough deletions that the valueMatcher is rebuilt from the values which remain
+	_ = q.DeletePatterns(1)
+	_ = q.DeletePatterns(3)
+	matches, _ = q.MatchesForEvent(event)
+	if !sameXs(matches, []X{2, 4}) {
+		t.Errorf("after deletion, matched %v", matches)
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"x": "tortillas"}`))
+	if !sameXs(matches, []X{2}) {
+		t.Errorf("after deletion, matched %v", matches)
+	}
+}
+
+// TestDeletePatternsCopies deletes copies of patterns for other Xs, which rebuilds the valueMatcher from the
+// values which remain, many times over, since a rebuild which depended on the order of a map would only
+// sometimes go wrong
+func TestDeletePatternsCopies(t *testing.T) {
+	patterns := []string{
+		`{"a": [{"anything-but": ["x"]}]}`,
+		`{"a": [{"shellstyle": "*b*"}], "b": ["q"]}`,
+		`{"a": ["1"]}`,
+	}
+	wanted := map[string][]X{
+		`{"a": "xyz"}`:           {"P0"},
+		`{"a": "x"}`:             nil,
+		`{"a": "1"}`:             {"P0", "P2"},
+		`{"a": "abc", "b": "q"}`: {"P0", "P1"},
+		`{"a": "b", "b": "q"}`:   {"P0", "P1"},
+	}
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		for run := 0; run < 50; run++ {
+			q, _ := New(WithPatternDeletion(true))
+			_ = q.SetMatcherBuildMode(mode)
+			for i, pattern := range patterns {
+				for _, prefix := range []string{"P", "J"} {
+					if err := q.AddPattern(fmt.Sprintf("%s%d", prefix, i), pattern); err != nil {
+						t.Fatal(err.Error())
+					}
+				}
+			}
+			for i := range patterns {
+				if err := q.DeletePatterns(fmt.Sprintf("J%d", i)); err != nil {
+					t.Fatal(err.Error())
+				}
+			}
+			for event, want := range wanted {
+				matches, err := q.MatchesForEvent([]byte(event))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				if !sameXs(matches, want) {
+					t.Fatalf("mode %d, run %d, %s: wanted %v got %v", mode, run, event, want, matches)
+				}
+			}
+		}
+	}
+}
+
+func TestDeletePatternsSome(t *testing.T) {
+	const n = 500
+	cm := func(q *Quamina) *coreMatcher { return q.matcher.(*coreMatcher) }
+	viaEvent := func(q *Quamina, event []byte) ([]X, error) { return q.MatchesForEvent(event) }
+	viaFields := func(q *Quamina, event []byte) ([]X, error) {
+		fields, err := newJSONFlattener().Flatten(event, cm(q).getSegmentsTreeTracker())
+		if err != nil {
+			return nil, err
+		}
+		return cm(q).matchesForFields(fields, newNfaBuffers())
+	}
+	query := func(t *testing.T, q *Quamina, match func(*Quamina, []byte) ([]X, error), deleted func(int) bool) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			matches, err := match(q, []byte(fmt.Sprintf(`{"like": "tacos", "want": %d}`, i)))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if deleted(i) && len(matches) != 0 {
+				t.Fatalf("deleted %d matched %v", i, matches)
+			}
+			if !deleted(i) && !sameXs(matches, []X{i}) {
+				t.Fatalf("%d matched %v", i, matches)
+			}
+		}
+	}
+	pattern := func(i int) string { return fmt.Sprintf(`{"like": ["tacos", "queso"], "want": [%d]}`, i) }
+	even := func(i int) bool { return i%2 == 0 }
+	none := func(int) bool { return false }
+	all := func(int) bool { return true }
+
+	for name, match := range map[string]func(*Quamina, []byte) ([]X, error){"event": viaEvent, "fields": viaFields} {
+		t.Run(name+"/after", func(t *testing.T) {
+			q, _ := New(WithPatternDeletion(true))
+			for i := 0; i < n; i++ {
+				if err := q.AddPattern(i, pattern(i)); err != nil {
+					t.Fatal(err.Error())
+				}
+			}
+			query(t, q, match, none)
+			for i := 0; i < n; i += 2 {
+				if err := q.DeletePatterns(i); err != nil {
+					t.Fatal(err.Error())
+				}
+			}
+			query(t, q, match, even)
+			for i := 1; i < n; i += 2 {
+				if err := q.DeletePatterns(i); err != nil {
+					t.Fatal(err.Error())
+				}
+			}
+			query(t, q, match, all)
+		})
+		t.Run(name+"/interleaved", func(t *testing.T) {
+			q, _ := New(WithPatternDeletion(true))
+			for i := 0; i < n; i++ {
+				if err := q.AddPattern(i, pattern(i)); err != nil {
+					t.Fatal(err.Error())
+				}
+				if even(i) {
+					if err := q.DeletePatterns(i); err != nil {
+						t.Fatal(err.Error())
+					}
+				}
+			}
+			query(t, q, match, even)
+		})
+	}
+}
+
+func TestDeletePatternsBadInput(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	deletion := q.matcher.(*coreMatcher).deletion
+	if err := q.AddPattern(1, `Dream baby dream`); err == nil {
+		t.Error("accepted bad pattern")
+	}
+	if err := q.AddPatterns([]PatternSpec{{X: 2, Pattern: `{"wants": ["queso"]}`}, {X: 3, Pattern: `{"wants": [`}}); err == nil {
+		t.Error("accepted bad pattern in bulk")
+	}
+	if len(deletion.ends) != 0 || len(deletion.parents) != 0 || len(deletion.values) != 0 {
+		t.Errorf("bad patterns indexed: %d ends, %d parents, %d valueMatchers",
+			len(deletion.ends), len(deletion.parents), len(deletion.values))
+	}
+	if _, err := q.MatchesForEvent([]byte(`My heart's not in it`)); err == nil {
+		t.Error("accepted bad event")
+	}
+
+	// and the matcher still works
+	if err := q.AddPattern(1, `{"wants": ["queso"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"wants": "queso"}`))
+	if !sameXs(matches, []X{1}) {
+		t.Errorf("matched %v", matches)
+	}
+	if err := q.DeletePatterns(1); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"wants": "queso"}`))
+	if len(matches) != 0 {
+		t.Errorf("deleted pattern matched %v", matches)
+	}
+}
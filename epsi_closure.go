@@ -205,10 +205,10 @@ func closureForState(state *faState, bufs *closureBuffers) {
 	}
 
 	// selfWasCollected reports whether `state` itself was added to closureList
-	// below. Only non-epsilon-only states are collected, so when self is
-	// epsilon-only a closureList of length 1 holds some *other* state, not self
+	// below. Only non-pass-through states are collected, so when self is
+	// pass-through a closureList of length 1 holds some *other* state, not self
 	// — the self-only checks must not fire on it.
-	selfWasCollected := !state.table.isEpsilonOnly()
+	selfWasCollected := !state.isPassThrough()
 
 	// Generation-based visited tracking: bufs.states records which gen last
 	// visited each state, so we never clear the map between traversals.
@@ -221,7 +221,7 @@ func closureForState(state *faState, bufs *closureBuffers) {
 	}
 	traverseEpsilons(state, state.table.epsilons, bufs)
 
-	// Self-only closure (no other reachable non-epsilon-only state): use the
+	// Self-only closure (no other reachable non-pass-through state): use the
 	// shared sentinel instead of allocating a 1-element slice. closureList has
 	// length 1 exactly when only `self` was collected.
 	if selfWasCollected && len(bufs.closureList) == 1 {
@@ -236,7 +236,7 @@ func closureForState(state *faState, bufs *closureBuffers) {
 	// closure entirely onto self appears structurally unreachable.
 	if selfWasCollected && len(closure) == 1 {
 		// dedup collapsed everything into self (self was the sole surviving
-		// representative); use the sentinel. Guard: epsilon-only states are
+		// representative); use the sentinel. Guard: pass-through states are
 		// not self-added to closureList, so a singleton closure there means
 		// one other state survived, not self.
 		state.epsilonClosure = selfOnlyClosure
@@ -276,7 +276,7 @@ func dedupByTableShare(bufs *closureBuffers) []*faState {
 	return closure
 }
 
-// traverseEpsilons recursively collects non-epsilon-only states reachable
+// traverseEpsilons recursively collects non-pass-through states reachable
 // via epsilon transitions into bufs.closureList.
 func traverseEpsilons(start *faState, epsilons []*faState, bufs *closureBuffers) {
 	for _, eps := range epsilons {
@@ -284,7 +284,7 @@ func traverseEpsilons(start *faState, epsilons []*faState, bufs *closureBuffers)
 			continue
 		}
 		bufs.states[eps] = bufs.closureSetGen
-		if !eps.table.isEpsilonOnly() {
+		if !eps.isPassThrough() {
 			bufs.closureList = append(bufs.closureList, eps)
 		}
 		traverseEpsilons(start, eps.table.epsilons, bufs)
@@ -0,0 +1,114 @@
+package quamina
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+// readEqualsFieldSpecial reads the value of an "equals-field" pattern, e.g. {"equals-field": "detail.owner"},
+// which matches if the event contains a field at the referenced path whose value is the same. The path's
+// segments are separated by '.', and it is stored in the typedVal in the form used by Field.Path.
+func readEqualsFieldSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	t, err := pb.jd.Token()
+	if err != nil {
+		return
+	}
+	pathVals = valsIn
+
+	refPath, ok := t.(string)
+	if !ok {
+		err = errors.New("value for 'equals-field' must be a string")
+		return
+	}
+	segments := strings.Split(refPath, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			err = errors.New("invalid path for 'equals-field': " + refPath)
+			return
+		}
+	}
+	refPath = strings.Join(segments, SegmentSeparator)
+	if pb.opts.foldFieldNames {
+		refPath = string(foldCase(nil, []byte(refPath)))
+	}
+	if refPath == strings.Join(pb.path, SegmentSeparator) {
+		err = errors.New("'equals-field' can't refer to the field's own path: " + strings.Join(segments, "."))
+		return
+	}
+	pathVals = append(pathVals, typedVal{vType: equalsFieldType, val: refPath})
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
+
+// addEqualsField adds a transition on the field's path which is only taken if the event has a field at the path
+// referred to by the pattern, with an equal value
+func (m *fieldMatcher) addEqualsField(field *patternField) []*fieldMatcher {
+	current := m.fields()
+	freshStart := &fmFields{
+		transitions:             current.transitions,
+		matches:                 current.matches,
+		existsTrue:              current.existsTrue,
+		existsFalse:             current.existsFalse,
+		equalsField:             make(map[string]map[string]*fieldMatcher),
+		existsFalseIgnoringNull: current.existsFalseIgnoringNull,
+	}
+	for path, refs := range current.equalsField {
+		freshStart.equalsField[path] = refs
+	}
+
+	// equals-field is exclusive so there's only one val
+	refPath := field.vals[0].val
+	freshRefs := make(map[string]*fieldMatcher)
+	for ref, trans := range current.equalsField[field.path] {
+		freshRefs[ref] = trans
+	}
+	trans, ok := freshRefs[refPath]
+	if !ok {
+		trans = newFieldMatcher()
+		freshRefs[refPath] = trans
+	}
+	freshStart.equalsField[field.path] = freshRefs
+	m.update(freshStart)
+	return []*fieldMatcher{trans}
+}
+
+// equalsFieldPresent checks whether there is a field other than fields[index] whose path is refPath, whose
+// value is equal to that of fields[index], and which is not in a different element of the same array.
+// refPath is never the path of fields[index]; readEqualsFieldSpecial rejects patterns which refer to their own path.
+func equalsFieldPresent(fields []Field, index int, refPath string, exactIntegers bool) bool {
+	field := &fields[index]
+	for i := range fields {
+		if i == index || string(fields[i].Path) != refPath {
+			continue
+		}
+		if fieldValuesEqual(field, &fields[i], exactIntegers) && noArrayTrailConflict(field.ArrayTrail, fields[i].ArrayTrail) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldValuesEqual compares the values of two fields. Numbers are compared in the form used by the automata,
+// so that 3 and 3.0 are equal, just as a pattern containing 3 would match either, and integers too large to be
+// represented exactly as float64 are only equal to each other if they're the same, if exactIntegers is set.
+func fieldValuesEqual(a *Field, b *Field, exactIntegers bool) bool {
+	if bytes.Equal(a.Val, b.Val) {
+		return true
+	}
+	if !a.IsNumber || !b.IsNumber {
+		return false
+	}
+	var bufA, bufB [MaxBytesInEncoding]byte
+	na, err := numberForm(exactIntegers, a.Val, &bufA)
+	if err != nil {
+		return false
+	}
+	nb, err := numberForm(exactIntegers, b.Val, &bufB)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(na, nb)
+}
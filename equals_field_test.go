@@ -0,0 +1,132 @@
+package quamina
+
+import (
+	"testing"
+)
+
+func TestEqualsField(t *testing.T) {
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{"sameUser", `{"detail": {"modifiedBy": [{"equals-field": "detail.owner"}]}}`},
+		{"sameUserInRegion", `{"region": ["us"], "detail": {"modifiedBy": [{"equals-field": "detail.owner"}]}}`},
+		{"sameSize", `{"size": [{"equals-field": "limit"}]}`},
+	}
+	events := []struct {
+		event   string
+		matches []string
+	}{
+		{`{"detail": {"owner": "bob", "modifiedBy": "bob"}}`, []string{"sameUser"}},
+		{`{"detail": {"modifiedBy": "bob", "owner": "bob"}, "region": "us"}`, []string{"sameUser", "sameUserInRegion"}},
+		{`{"detail": {"owner": "bob", "modifiedBy": "alice"}, "region": "us"}`, []string{}},
+		{`{"detail": {"modifiedBy": "bob"}}`, []string{}},
+		{`{"detail": {"owner": "bob", "modifiedBy": ["alice", "bob"]}}`, []string{"sameUser"}},
+		{`{"size": 30, "limit": 3.0e1}`, []string{"sameSize"}},
+		{`{"size": 30, "limit": "30"}`, []string{}},
+		{`{"size": true, "limit": true}`, []string{"sameSize"}},
+	}
+
+	q, _ := New()
+	for _, p := range patterns {
+		if err := q.AddPattern(p.name, p.pattern); err != nil {
+			t.Fatalf("AddPattern %s: %s", p.name, err.Error())
+		}
+	}
+	for _, e := range events {
+		matches, err := q.MatchesForEvent([]byte(e.event))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(matches) != len(e.matches) {
+			t.Errorf("%s: wanted %v got %v", e.event, e.matches, matches)
+			continue
+		}
+		for _, want := range e.matches {
+			if !containsX(matches, want) {
+				t.Errorf("%s: wanted %v got %v", e.event, e.matches, matches)
+			}
+		}
+	}
+}
+
+func TestEqualsFieldArrays(t *testing.T) {
+	q, _ := New()
+	if err := q.AddPattern("p", `{"items": {"a": [{"equals-field": "items.b"}]}}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"items": [{"a": 1, "b": 2}, {"a": 2, "b": 1}]}`))
+	if len(matches) != 0 {
+		t.Errorf("matched across array elements: %v", matches)
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"items": [{"a": 1, "b": 2}, {"a": 3, "b": 3}]}`))
+	if len(matches) != 1 {
+		t.Errorf("didn't match within array element: %v", matches)
+	}
+}
+
+func TestEqualsFieldExactIntegers(t *testing.T) {
+	pattern := `{"a": [{"equals-field": "b"}]}`
+	events := []struct {
+		event        string
+		exact, float bool
+	}{
+		{`{"a": 9007199254740993, "b": 9007199254740992}`, false, true},
+		{`{"a": 9007199254740993, "b": 9007199254740993}`, true, true},
+		{`{"a": 9007199254740993, "b": 9.007199254740993e15}`, true, true},
+		{`{"a": 1234567890123456789, "b": 1234567890123456788}`, false, true},
+		{`{"a": 30, "b": 3.0e1}`, true, true},
+	}
+	for _, exact := range []bool{true, false} {
+		q, _ := New(WithExactIntegers(exact))
+		frozen, _ := New(WithExactIntegers(exact))
+		for _, matcher := range []*Quamina{q, frozen} {
+			if err := matcher.AddPattern("p", pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		frozen.Freeze()
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			want := e.float
+			if exact {
+				want = e.exact
+			}
+			if (len(matches) == 1) != want {
+				t.Errorf("exact %v, %s: matched %v", exact, e.event, matches)
+			}
+			matches, _ = frozen.MatchesForEvent([]byte(e.event))
+			if (len(matches) == 1) != want {
+				t.Errorf("exact %v, frozen, %s: matched %v", exact, e.event, matches)
+			}
+		}
+	}
+}
+
+func TestEqualsFieldSyntax(t *testing.T) {
+	bad := []string{
+		`{"a": [{"equals-field": 3}]}`,
+		`{"a": [{"equals-field": ""}]}`,
+		`{"a": [{"equals-field": "b..c"}]}`,
+		`{"a": [{"equals-field": "b"}, "x"]}`,
+		`{"a": [{"equals-field": "b", "x": 1}]}`,
+		`{"a": [{"equals-field": "a"}]}`,
+		`{"a": {"b": [{"equals-field": "a.b"}]}}`,
+	}
+	for _, b := range bad {
+		_, err := patternFromJSON([]byte(b))
+		if err == nil {
+			t.Errorf("accepted %s", b)
+		}
+	}
+	fields, err := patternFromJSON([]byte(`{"a": [{"equals-field": "b.c"}]}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if fields[0].vals[0].vType != equalsFieldType || fields[0].vals[0].val != "b\nc" {
+		t.Errorf("bad equals-field val %v", fields[0].vals[0])
+	}
+}
@@ -0,0 +1,39 @@
+package quamina
+
+// Exclusion patterns are added with AddExclusionPattern. If an event matches an exclusion pattern for some X,
+// then X is not reported by MatchesForEvent, even if other patterns for X match.
+// They are stored in the automaton just like ordinary patterns, except that the X value is wrapped in an
+// exclusionX, which can't be confused with any X provided by the caller because it's unexported. When the
+// automaton's results include any exclusionX values, applyExclusions removes those and the X values they wrap.
+
+// exclusionX wraps the X value of an exclusion pattern.
+type exclusionX struct {
+	x X
+}
+
+// applyExclusions removes from xs any exclusionX values and the X values they exclude. The filtering is done in
+// place, so the returned slice shares storage with xs.
+func applyExclusions(xs []X) []X {
+	var excluded map[X]bool
+	for _, x := range xs {
+		if ex, ok := x.(exclusionX); ok {
+			if excluded == nil {
+				excluded = make(map[X]bool)
+			}
+			excluded[ex.x] = true
+		}
+	}
+	if excluded == nil {
+		return xs
+	}
+	kept := xs[:0]
+	for _, x := range xs {
+		if _, ok := x.(exclusionX); ok {
+			continue
+		}
+		if !excluded[x] {
+			kept = append(kept, x)
+		}
+	}
+	return kept
+}
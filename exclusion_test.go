@@ -0,0 +1,119 @@
+package quamina
+
+import (
+	"testing"
+)
+
+func TestExclusionPatterns(t *testing.T) {
+	for _, deletion := range []bool{false, true} {
+		q, _ := New(WithPatternDeletion(deletion))
+		if err := q.AddPattern("alerts", `{"level": ["error", "fatal"]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := q.AddPattern("alerts", `{"source": ["pager"]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := q.AddPattern("audit", `{"level": [{"exists": true}]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := q.AddExclusionPattern("alerts", `{"env": ["test"]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := q.AddExclusionPattern("alerts", `{"host": [{"prefix": "dev-"}]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := q.AddExclusionPattern("nobody", `{"level": ["error"]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := q.AddExclusionPattern("x", `{"level": ["error"`); err == nil {
+			t.Error("accepted bad exclusion pattern")
+		}
+
+		events := []struct {
+			event   string
+			matches []string
+		}{
+			{`{"level": "error"}`, []string{"alerts", "audit"}},
+			{`{"level": "error", "env": "test"}`, []string{"audit"}},
+			{`{"source": "pager", "host": "dev-3"}`, []string{}},
+			{`{"source": "pager", "host": "prod-3"}`, []string{"alerts"}},
+			{`{"env": "test"}`, []string{}},
+		}
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if len(matches) != len(e.matches) {
+				t.Errorf("deletion %v, %s: wanted %v got %v", deletion, e.event, e.matches, matches)
+				continue
+			}
+			for _, want := range e.matches {
+				if !containsX(matches, want) {
+					t.Errorf("deletion %v, %s: wanted %v got %v", deletion, e.event, e.matches, matches)
+				}
+			}
+		}
+	}
+}
+
+func TestExclusionPatternDeletion(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	_ = q.AddPattern("alerts", `{"level": ["error"]}`)
+	_ = q.AddExclusionPattern("alerts", `{"env": ["test"]}`)
+	event := []byte(`{"level": "error", "env": "test"}`)
+	matches, _ := q.MatchesForEvent(event)
+	if len(matches) != 0 {
+		t.Errorf("exclusion failed: %v", matches)
+	}
+
+	// deleting x removes its exclusions too, so re-adding just the positive pattern matches
+	if err := q.DeletePatterns("alerts"); err != nil {
+		t.Fatal(err.Error())
+	}
+	_ = q.AddPattern("alerts", `{"level": ["error"]}`)
+	matches, _ = q.MatchesForEvent(event)
+	if len(matches) != 1 || matches[0] != "alerts" {
+		t.Errorf("deleted exclusion still applies: %v", matches)
+	}
+}
+
+func TestExclusionPatternDeletionFrozen(t *testing.T) {
+	q, _ := New(WithPatternDeletion(true))
+	_ = q.AddPattern("alerts", `{"level": ["error"]}`)
+	_ = q.AddExclusionPattern("alerts", `{"env": ["test"]}`)
+	q.Freeze()
+	event := []byte(`{"level": "error", "env": "test"}`)
+	matches, _ := q.MatchesForEvent(event)
+	if len(matches) != 0 {
+		t.Errorf("exclusion failed after Freeze: %v", matches)
+	}
+
+	// the frozen exclusion is deleted along with the frozen positive pattern
+	if err := q.DeletePatterns("alerts"); err != nil {
+		t.Fatal(err.Error())
+	}
+	_ = q.AddPattern("alerts", `{"level": ["error"]}`)
+	matches, _ = q.MatchesForEvent(event)
+	if len(matches) != 1 || matches[0] != "alerts" {
+		t.Errorf("deleted frozen exclusion still applies: %v", matches)
+	}
+	q.Freeze()
+	matches, _ = q.MatchesForEvent(event)
+	if len(matches) != 1 || matches[0] != "alerts" {
+		t.Errorf("deleted exclusion came back after Freeze: %v", matches)
+	}
+}
+
+func TestApplyExclusions(t *testing.T) {
+	xs := []X{"a", "b", exclusionX{"a"}, "c", exclusionX{"d"}}
+	got := applyExclusions(xs)
+	if len(got) != 2 || !containsX(got, "b") || !containsX(got, "c") {
+		t.Errorf("got %v", got)
+	}
+	xs = []X{"a", "b"}
+	got = applyExclusions(xs)
+	if len(got) != 2 {
+		t.Errorf("got %v", got)
+	}
+}
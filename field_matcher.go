@@ -18,11 +18,16 @@ type fieldMatcher struct {
 // fieldMatcher.
 // matches contains the X values that arrival at this state implies have matched.
 // existsTrue and existsFalse record those types of patterns; traversal doesn't require looking at a valueMatcher
+// existsFalseIgnoringNull is like existsFalse, except that fields whose value is null don't count
+// equalsField is keyed by field path and then by the path of the field whose value must be equal; see equals_field.go
 type fmFields struct {
 	transitions map[string]*valueMatcher
 	matches     []X
 	existsTrue  map[string]*fieldMatcher
 	existsFalse map[string]*fieldMatcher
+	equalsField map[string]map[string]*fieldMatcher
+
+	existsFalseIgnoringNull map[string]*fieldMatcher
 }
 
 // fields / update / addExistsFalseFailure / addMatch exist to insulate callers from dealing with
@@ -35,12 +40,26 @@ func (m *fieldMatcher) update(fields *fmFields) {
 	m.updateable.Store(fields)
 }
 
+// pathEdges returns the map of fieldMatchers for an exists transition kind
+func (fields *fmFields) pathEdges(kind transitionKind) map[string]*fieldMatcher {
+	switch kind {
+	case existsTrueTransition:
+		return fields.existsTrue
+	case existsFalseTransition:
+		return fields.existsFalse
+	default:
+		return fields.existsFalseIgnoringNull
+	}
+}
+
 func (m *fieldMatcher) addMatch(x X) {
 	current := m.fields()
 	newFields := &fmFields{
-		transitions: current.transitions,
-		existsTrue:  current.existsTrue,
-		existsFalse: current.existsFalse,
+		transitions:             current.transitions,
+		existsTrue:              current.existsTrue,
+		existsFalse:             current.existsFalse,
+		equalsField:             current.equalsField,
+		existsFalseIgnoringNull: current.existsFalseIgnoringNull,
 	}
 
 	newFields.matches = append(newFields.matches, current.matches...)
@@ -50,9 +69,11 @@ func (m *fieldMatcher) addMatch(x X) {
 
 func newFieldMatcher() *fieldMatcher {
 	fields := &fmFields{
-		transitions: make(map[string]*valueMatcher),
-		existsTrue:  make(map[string]*fieldMatcher),
-		existsFalse: make(map[string]*fieldMatcher),
+		transitions:             make(map[string]*valueMatcher),
+		existsTrue:              make(map[string]*fieldMatcher),
+		existsFalse:             make(map[string]*fieldMatcher),
+		equalsField:             make(map[string]map[string]*fieldMatcher),
+		existsFalseIgnoringNull: make(map[string]*fieldMatcher),
 	}
 	fm := &fieldMatcher{}
 	fm.updateable.Store(fields)
@@ -60,46 +81,52 @@ func newFieldMatcher() *fieldMatcher {
 }
 
 func (m *fieldMatcher) addExists(exists bool, field *patternField) []*fieldMatcher {
-	var trans *fieldMatcher
 	current := m.fields()
 	freshStart := &fmFields{
-		transitions: current.transitions,
-		matches:     current.matches,
-		existsTrue:  make(map[string]*fieldMatcher),
-		existsFalse: make(map[string]*fieldMatcher),
+		transitions:             current.transitions,
+		matches:                 current.matches,
+		existsTrue:              current.existsTrue,
+		existsFalse:             current.existsFalse,
+		equalsField:             current.equalsField,
+		existsFalseIgnoringNull: current.existsFalseIgnoringNull,
 	}
-	var path string
-	for path, trans = range current.existsTrue {
-		freshStart.existsTrue[path] = trans
-	}
-	for path, trans = range current.existsFalse {
-		freshStart.existsFalse[path] = trans
-	}
-	var ok bool
-	if exists {
-		trans, ok = freshStart.existsTrue[field.path]
-		if !ok {
-			trans = newFieldMatcher()
-			freshStart.existsTrue[field.path] = trans
-		}
-	} else {
-		trans, ok = freshStart.existsFalse[field.path]
-		if !ok {
-			trans = newFieldMatcher()
-			freshStart.existsFalse[field.path] = trans
-		}
+	var trans *fieldMatcher
+	switch {
+	case exists:
+		freshStart.existsTrue, trans = addExistsTransition(current.existsTrue, field.path)
+	case field.vals[0].vType == existsFalseIgnoringNullType:
+		freshStart.existsFalseIgnoringNull, trans = addExistsTransition(current.existsFalseIgnoringNull, field.path)
+	default:
+		freshStart.existsFalse, trans = addExistsTransition(current.existsFalse, field.path)
 	}
 	m.update(freshStart)
 	return []*fieldMatcher{trans}
 }
 
-func (m *fieldMatcher) addTransition(field *patternField, printer printer, bufs *closureBuffers, buildMode MatcherBuildMode) []*fieldMatcher {
+// addExistsTransition returns a copy of the transitions map which includes a transition on path, and
+// the fieldMatcher that transition leads to
+func addExistsTransition(transitions map[string]*fieldMatcher, path string) (map[string]*fieldMatcher, *fieldMatcher) {
+	fresh := make(map[string]*fieldMatcher, len(transitions)+1)
+	for p, trans := range transitions {
+		fresh[p] = trans
+	}
+	trans, ok := fresh[path]
+	if !ok {
+		trans = newFieldMatcher()
+		fresh[path] = trans
+	}
+	return fresh, trans
+}
+
+func (m *fieldMatcher) addTransition(field *patternField, printer printer, bufs *closureBuffers, buildMode MatcherBuildMode, opts *patternOptions) []*fieldMatcher {
 	// we build the new updateable state in freshStart so that we can blast it in atomically once computed
 	current := m.fields()
 	freshStart := &fmFields{
-		matches:     current.matches,
-		existsTrue:  current.existsTrue,
-		existsFalse: current.existsFalse,
+		matches:                 current.matches,
+		existsTrue:              current.existsTrue,
+		existsFalse:             current.existsFalse,
+		equalsField:             current.equalsField,
+		existsFalseIgnoringNull: current.existsFalseIgnoringNull,
 	}
 
 	freshStart.transitions = make(map[string]*valueMatcher)
@@ -119,13 +146,59 @@ func (m *fieldMatcher) addTransition(field *patternField, printer printer, bufs
 	//  cases where this doesn't happen and reduce the number of fieldMatchStates
 	var nextFieldMatchers []*fieldMatcher
 	for _, val := range field.vals {
+		if val.vType == numberType && opts.exactIntegers {
+			vm.matchExactIntegers()
+		}
 		nextFieldMatcher := vm.addTransition(val, printer, bufs, buildMode)
 		nextFieldMatchers = append(nextFieldMatchers, nextFieldMatcher)
+		if val.vType == numberType && opts.numericStrings {
+			vm.matchNumericStrings()
+		}
 	}
 	m.update(freshStart)
 	return nextFieldMatchers
 }
 
+// pointerAutomaton is the fieldAutomaton for the fieldMatchers which addPattern builds
+type pointerAutomaton struct{}
+
+func (pointerAutomaton) matchesOf(fm *fieldMatcher) []X {
+	return fm.fields().matches
+}
+
+func (pointerAutomaton) existsTrueOn(fm *fieldMatcher, path []byte) (*fieldMatcher, bool) {
+	next, ok := fm.fields().existsTrue[string(path)]
+	return next, ok
+}
+
+func (pointerAutomaton) eachExistsFalse(fm *fieldMatcher, index int, m *fieldMatch[*fieldMatcher]) {
+	fields := fm.fields()
+	for path, next := range fields.existsFalse {
+		m.tryExistsFalse(index, path, next, false)
+	}
+	for path, next := range fields.existsFalseIgnoringNull {
+		m.tryExistsFalse(index, path, next, true)
+	}
+}
+
+func (pointerAutomaton) eachEqualsField(fm *fieldMatcher, index int, m *fieldMatch[*fieldMatcher]) {
+	for refPath, next := range fm.fields().equalsField[string(m.fields[index].Path)] {
+		m.tryEqualsField(index, refPath, next)
+	}
+}
+
+func (pointerAutomaton) transitionOn(fm *fieldMatcher, field *Field, bufs *nfaBuffers) []*fieldMatcher {
+	return fm.transitionOn(field, bufs)
+}
+
+func (pointerAutomaton) pushTransitions(bufs *nfaBuffers) {
+	bufs.getTransmap().push()
+}
+
+func (pointerAutomaton) popTransitions(bufs *nfaBuffers) {
+	bufs.getTransmap().pop()
+}
+
 // transitionOn returns one or more fieldMatchStates you can transition to on a field's name/value combination,
 // or nil if no transitions are possible.  An example of name/value that could produce multiple next states
 // would be if you had the pattern { "a": [ "foo" ] } and another pattern that matched any value with
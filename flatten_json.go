@@ -23,6 +23,7 @@ type flattenJSON struct {
 	arrayTrail     []ArrayPos // current array-position cookie crumbs
 	arrayCount     int32      // how many arrays we've seen, used in building arrayTrail
 	arrayPosBuffer []ArrayPos // batch allocation buffer for ArrayTrail slices
+	closedPaths    [][]byte   // scratch for reporting members of closed objects
 	cleanSheet     bool       // initially true, don't have to call Reset()
 	isSpace        [256]bool
 }
@@ -150,8 +151,11 @@ func (fj *flattenJSON) readObject(pathNode SegmentsTreeTracker) error {
 	}
 
 	// how many leaf states (fieldsCount) and childStructures (nodesCount) have been mentioned in patterns?
+	// Note that if the object is closed, the count includes the closed-object markers, which are never
+	// decremented, so that the whole object is read.
 	fieldsCount := pathNode.FieldsCount()
 	nodesCount := pathNode.NodesCount()
+	closedTracker, _ := pathNode.(closedObjectsTracker)
 
 	// make a snapshot of the current ArrayPos trail for use in any member fields, because it doesn't change in
 	//  the course of reading an object
@@ -203,6 +207,14 @@ func (fj *flattenJSON) readObject(pathNode SegmentsTreeTracker) error {
 				// we know the name of the next object member, use the pathNode to check if it's used
 				segmentIsUsed = pathNode.IsSegmentUsed(memberName)
 				memberIsUsed = (fj.skipping == 0) && segmentIsUsed
+
+				// if patterns have closed this object, members they don't mention must be reported
+				if closedTracker != nil && fj.skipping == 0 {
+					fj.closedPaths = closedTracker.closedPathsViolatedBy(memberName, fj.closedPaths[:0])
+					for _, closedPath := range fj.closedPaths {
+						fj.storeObjectMemberField(closedPath, arrayTrail, closedViolationBytes, false)
+					}
+				}
 				state = fjSeekingColonState
 			case ch == '}':
 				return nil
@@ -261,7 +273,9 @@ func (fj *flattenJSON) readObject(pathNode SegmentsTreeTracker) error {
 					err = fj.skipBlock('[', ']')
 				} else {
 					arrayPathNode, ok := pathNode.Get(memberName)
-					if !ok {
+					if ok {
+						fj.storePresenceMarker(arrayPathNode, arrayTrail)
+					} else {
 						// Arrays are interesting, they can be field or node.
 						// Given this case:
 						//  { "geo": { "coords": [{"coordinates": [1,2,3]}] } }
@@ -291,6 +305,8 @@ func (fj *flattenJSON) readObject(pathNode SegmentsTreeTracker) error {
 						// Currently, we don't support this case, so we will skip the block.
 						err = fj.skipBlock('{', '}')
 					} else {
+						fj.storePresenceMarker(objectPathNode, arrayTrail)
+
 						// Traversing into node, reduce the count.
 						nodesCount--
 
@@ -0,0 +1,1026 @@
+package quamina
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// frozenMatcher is the compiled form of a coreMatcher's automaton which Freeze produces. The graph of
+// fieldMatchers, valueMatchers, and faStates that AddPattern builds is full of pointers, maps, and small
+// slices, which is what makes it cheap to update, but for very large matchers, the garbage collector has
+// to scan all of it, repeatedly. In the frozen form, each kind of node lives in a flat slice and refers to
+// others by their indexes, and all the variable-length data lives in a few more flat slices, addressed by
+// spans. None of these slices' elements contain pointers, so the garbage collector doesn't look inside
+// them. The exceptions are the X values of matches and the functions of custom-operator predicates, which
+// belong to the caller.
+// A frozenMatcher can't be changed. Patterns added after Freeze go into the coreMatcher's ordinary
+// automaton, which serves as an overlay; matching consults both. The next Freeze thaws the frozenMatcher
+// back into pointer form, merges the overlay into it, and freezes the result.
+// If the frozenMatcher is built in BuiltLazily mode, each nondeterministic valueMatcher gets a slot in
+// lazyDFAs, which holds its frozenLazyDFA once matching has needed it.
+type frozenMatcher struct {
+	root        int32
+	fms         []frozenFieldMatcher
+	vms         []frozenValueMatcher
+	states      []frozenState
+	ceilings    []byte  // with steps, the smallTables of the states
+	steps       []int32 // state indexes, -1 for no transition
+	stateRefs   []int32 // epsilons and epsilon closures
+	fmRefs      []int32 // fieldTransitions and literal transitions
+	pathEdges   []frozenPathEdge
+	equalsEdges []frozenEqualsEdge
+	arena       []byte // paths and singleton values
+	xs          []X
+	predicates  []frozenPredicate
+	literals    []frozenLiterals
+	lazyDFAs    []atomic.Pointer[frozenLazyDFA]
+}
+
+// span locates a run of elements in one of frozenMatcher's slices
+type span struct {
+	off, len int32
+}
+
+// nilSpan marks a slice which was nil, as opposed to empty, where the difference matters
+var nilSpan = span{off: 0, len: -1}
+
+type frozenFieldMatcher struct {
+	transitions             span // into pathEdges, to vms
+	matches                 span // into xs
+	existsTrue              span // into pathEdges, to fms
+	existsFalse             span
+	existsFalseIgnoringNull span
+	equalsField             span // into equalsEdges
+}
+
+type frozenValueMatcher struct {
+	start               int32 // -1 if none
+	singletonMatch      span  // into arena; nilSpan if none
+	singletonTransition int32
+	predicates          span  // into predicates
+	literals            int32 // index in literals, -1 if none
+	lazyDFA             int32 // index in lazyDFAs, -1 if none
+	hasNumbers          bool
+	isNondeterministic  bool
+	numericStrings      bool
+	exactIntegers       bool
+	hasNonLiterals      bool
+}
+
+type frozenState struct {
+	table       span // into ceilings and steps
+	epsilons    span // into stateRefs
+	closure     span // into stateRefs; nilSpan if not computed, empty for the self-only closure
+	transitions span // into fmRefs
+	isSpinner   bool
+}
+
+// frozenPathEdge is a transition keyed by a field path, to a valueMatcher or fieldMatcher depending on where
+// it's used. Each node's edges are sorted by path.
+type frozenPathEdge struct {
+	path   span // into arena
+	target int32
+}
+
+type frozenEqualsEdge struct {
+	path, refPath span // into arena
+	target        int32
+}
+
+type frozenPredicate struct {
+	vType     valType
+	name      string
+	predicate func([]byte) bool
+	next      int32
+}
+
+// frozenLiterals is a literalDAWG whose transitions are spans in fmRefs. The nodes and edges slices are
+// shared with the literalDAWG, which is fine because neither ever changes.
+type frozenLiterals struct {
+	root        int32
+	nodes       []dawgNode
+	edges       []dawgEdge
+	transitions []span
+}
+
+func (fz *frozenMatcher) bytesAt(s span) []byte {
+	return fz.arena[s.off : s.off+s.len]
+}
+
+// stringAt returns a string sharing the arena's storage, which is safe because the arena never changes
+func (fz *frozenMatcher) stringAt(s span) string {
+	if s.len == 0 {
+		return ""
+	}
+	return unsafe.String(&fz.arena[s.off], int(s.len))
+}
+
+//
+// Freezing
+//
+
+// freezer turns a fieldMatcher graph into a frozenMatcher. Each node gets its index when it is first
+// encountered, and is queued to be filled in.
+type freezer struct {
+	fz         *frozenMatcher
+	fmIndex    map[*fieldMatcher]int32
+	vmIndex    map[*valueMatcher]int32
+	stateIndex map[*faState]int32
+	fmQueue    []*fieldMatcher
+	vmQueue    []*valueMatcher
+	stateQueue []*faState
+}
+
+func freezeFieldMatcher(root *fieldMatcher) *frozenMatcher {
+	f := &freezer{
+		fz:         &frozenMatcher{},
+		fmIndex:    make(map[*fieldMatcher]int32),
+		vmIndex:    make(map[*valueMatcher]int32),
+		stateIndex: make(map[*faState]int32),
+	}
+	f.fz.root = f.fieldMatcher(root)
+	for len(f.fmQueue)+len(f.vmQueue)+len(f.stateQueue) > 0 {
+		for len(f.stateQueue) > 0 {
+			state := f.stateQueue[0]
+			f.stateQueue = f.stateQueue[1:]
+			f.freezeState(state)
+		}
+		for len(f.vmQueue) > 0 {
+			vm := f.vmQueue[0]
+			f.vmQueue = f.vmQueue[1:]
+			f.freezeValueMatcher(vm)
+		}
+		for len(f.fmQueue) > 0 {
+			fm := f.fmQueue[0]
+			f.fmQueue = f.fmQueue[1:]
+			f.freezeFieldMatcher(fm)
+		}
+	}
+	return f.fz
+}
+
+func (f *freezer) fieldMatcher(fm *fieldMatcher) int32 {
+	index, ok := f.fmIndex[fm]
+	if !ok {
+		index = int32(len(f.fz.fms))
+		f.fmIndex[fm] = index
+		f.fz.fms = append(f.fz.fms, frozenFieldMatcher{})
+		f.fmQueue = append(f.fmQueue, fm)
+	}
+	return index
+}
+
+func (f *freezer) valueMatcher(vm *valueMatcher) int32 {
+	index, ok := f.vmIndex[vm]
+	if !ok {
+		index = int32(len(f.fz.vms))
+		f.vmIndex[vm] = index
+		f.fz.vms = append(f.fz.vms, frozenValueMatcher{})
+		f.vmQueue = append(f.vmQueue, vm)
+	}
+	return index
+}
+
+func (f *freezer) state(state *faState) int32 {
+	if state == nil {
+		return -1
+	}
+	index, ok := f.stateIndex[state]
+	if !ok {
+		index = int32(len(f.fz.states))
+		f.stateIndex[state] = index
+		f.fz.states = append(f.fz.states, frozenState{})
+		f.stateQueue = append(f.stateQueue, state)
+	}
+	return index
+}
+
+func (f *freezer) bytes(b []byte) span {
+	s := span{off: int32(len(f.fz.arena)), len: int32(len(b))}
+	f.fz.arena = append(f.fz.arena, b...)
+	return s
+}
+
+func (f *freezer) fieldMatchers(fms []*fieldMatcher) span {
+	s := span{off: int32(len(f.fz.fmRefs)), len: int32(len(fms))}
+	for _, fm := range fms {
+		f.fz.fmRefs = append(f.fz.fmRefs, f.fieldMatcher(fm))
+	}
+	return s
+}
+
+func (f *freezer) states(states []*faState) span {
+	if states == nil {
+		return nilSpan
+	}
+	s := span{off: int32(len(f.fz.stateRefs)), len: int32(len(states))}
+	for _, state := range states {
+		f.fz.stateRefs = append(f.fz.stateRefs, f.state(state))
+	}
+	return s
+}
+
+// fmPathEdges freezes a map from paths to fieldMatchers
+func (f *freezer) fmPathEdges(m map[string]*fieldMatcher) span {
+	s := span{off: int32(len(f.fz.pathEdges)), len: int32(len(m))}
+	for _, path := range sortedKeys(m) {
+		f.fz.pathEdges = append(f.fz.pathEdges, frozenPathEdge{path: f.bytes([]byte(path)), target: f.fieldMatcher(m[path])})
+	}
+	return s
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (f *freezer) freezeFieldMatcher(fm *fieldMatcher) {
+	fields := fm.fields()
+	var frozen frozenFieldMatcher
+
+	frozen.transitions = span{off: int32(len(f.fz.pathEdges)), len: int32(len(fields.transitions))}
+	for _, path := range sortedKeys(fields.transitions) {
+		edge := frozenPathEdge{path: f.bytes([]byte(path)), target: f.valueMatcher(fields.transitions[path])}
+		f.fz.pathEdges = append(f.fz.pathEdges, edge)
+	}
+	frozen.matches = span{off: int32(len(f.fz.xs)), len: int32(len(fields.matches))}
+	f.fz.xs = append(f.fz.xs, fields.matches...)
+	frozen.existsTrue = f.fmPathEdges(fields.existsTrue)
+	frozen.existsFalse = f.fmPathEdges(fields.existsFalse)
+	frozen.existsFalseIgnoringNull = f.fmPathEdges(fields.existsFalseIgnoringNull)
+
+	frozen.equalsField.off = int32(len(f.fz.equalsEdges))
+	for _, path := range sortedKeys(fields.equalsField) {
+		refs := fields.equalsField[path]
+		pathSpan := f.bytes([]byte(path))
+		for _, refPath := range sortedKeys(refs) {
+			edge := frozenEqualsEdge{path: pathSpan, refPath: f.bytes([]byte(refPath)), target: f.fieldMatcher(refs[refPath])}
+			f.fz.equalsEdges = append(f.fz.equalsEdges, edge)
+		}
+	}
+	frozen.equalsField.len = int32(len(f.fz.equalsEdges)) - frozen.equalsField.off
+
+	f.fz.fms[f.fmIndex[fm]] = frozen
+}
+
+func (f *freezer) freezeValueMatcher(vm *valueMatcher) {
+	fields := vm.fields()
+	frozen := frozenValueMatcher{
+		start:               f.state(fields.start),
+		singletonMatch:      nilSpan,
+		singletonTransition: -1,
+		literals:            -1,
+		lazyDFA:             -1,
+		hasNumbers:          fields.hasNumbers,
+		isNondeterministic:  fields.isNondeterministic,
+		numericStrings:      fields.numericStrings,
+		exactIntegers:       fields.exactIntegers,
+		hasNonLiterals:      fields.hasNonLiterals,
+	}
+	if fields.singletonMatch != nil {
+		frozen.singletonMatch = f.bytes(fields.singletonMatch)
+		frozen.singletonTransition = f.fieldMatcher(fields.singletonTransition)
+	}
+	frozen.predicates = span{off: int32(len(f.fz.predicates)), len: int32(len(fields.predicates))}
+	for _, p := range fields.predicates {
+		fp := frozenPredicate{vType: p.vType, name: p.name, predicate: p.predicate, next: f.fieldMatcher(p.next)}
+		f.fz.predicates = append(f.fz.predicates, fp)
+	}
+	if fields.literals != nil {
+		frozen.literals = int32(len(f.fz.literals))
+		fl := frozenLiterals{root: fields.literals.root, nodes: fields.literals.nodes, edges: fields.literals.edges}
+		for _, transitions := range fields.literals.transitions {
+			fl.transitions = append(fl.transitions, f.fieldMatchers(transitions))
+		}
+		f.fz.literals = append(f.fz.literals, fl)
+	}
+	f.fz.vms[f.vmIndex[vm]] = frozen
+}
+
+// prepareLazyDFAs gives each nondeterministic valueMatcher a slot for a frozenLazyDFA, so that matching
+// proceeds as in BuiltLazily mode. It must be called before the frozenMatcher is used for matching.
+func (fz *frozenMatcher) prepareLazyDFAs() {
+	slots := 0
+	for i := range fz.vms {
+		if fz.vms[i].isNondeterministic && fz.vms[i].start >= 0 {
+			fz.vms[i].lazyDFA = int32(slots)
+			slots++
+		}
+	}
+	fz.lazyDFAs = make([]atomic.Pointer[frozenLazyDFA], slots)
+}
+
+func (f *freezer) freezeState(state *faState) {
+	frozen := frozenState{isSpinner: state.isSpinner}
+	frozen.table = span{off: int32(len(f.fz.ceilings)), len: int32(len(state.table.ceilings))}
+	f.fz.ceilings = append(f.fz.ceilings, state.table.ceilings...)
+	for _, step := range state.table.steps {
+		f.fz.steps = append(f.fz.steps, f.state(step))
+	}
+	frozen.epsilons = f.states(state.table.epsilons)
+	frozen.closure = f.states(state.epsilonClosure)
+	frozen.transitions = f.fieldMatchers(state.fieldTransitions)
+	f.fz.states[f.stateIndex[state]] = frozen
+}
+
+//
+// Thawing
+//
+
+// thaw rebuilds the fieldMatcher graph that the frozenMatcher was frozen from. All the slices are
+// allocated afresh, because the merging code appends to some of them. The graph is only used to be
+// frozen again, so the valueMatchers don't get lazyDFAs; the new frozenMatcher gets frozenLazyDFAs instead.
+func (fz *frozenMatcher) thaw() *fieldMatcher {
+	fms := make([]*fieldMatcher, len(fz.fms))
+	for i := range fms {
+		fms[i] = &fieldMatcher{}
+	}
+	states := make([]*faState, len(fz.states))
+	for i := range states {
+		states[i] = &faState{}
+	}
+	thawStates := func(s span) []*faState {
+		if s.len < 0 {
+			return nil
+		}
+		if s.len == 0 {
+			return selfOnlyClosure
+		}
+		thawed := make([]*faState, s.len)
+		for i, ref := range fz.stateRefs[s.off : s.off+s.len] {
+			thawed[i] = states[ref]
+		}
+		return thawed
+	}
+	thawFieldMatchers := func(s span) []*fieldMatcher {
+		if s.len == 0 {
+			return nil
+		}
+		thawed := make([]*fieldMatcher, s.len)
+		for i, ref := range fz.fmRefs[s.off : s.off+s.len] {
+			thawed[i] = fms[ref]
+		}
+		return thawed
+	}
+
+	for i, frozen := range fz.states {
+		state := states[i]
+		state.isSpinner = frozen.isSpinner
+		state.table.ceilings = slices.Clone(fz.ceilings[frozen.table.off : frozen.table.off+frozen.table.len])
+		state.table.steps = make([]*faState, frozen.table.len)
+		for j, step := range fz.steps[frozen.table.off : frozen.table.off+frozen.table.len] {
+			if step >= 0 {
+				state.table.steps[j] = states[step]
+			}
+		}
+		if frozen.epsilons.len > 0 {
+			state.table.epsilons = thawStates(frozen.epsilons)
+		}
+		state.epsilonClosure = thawStates(frozen.closure)
+		state.fieldTransitions = thawFieldMatchers(frozen.transitions)
+	}
+
+	vms := make([]*valueMatcher, len(fz.vms))
+	for i, frozen := range fz.vms {
+		fields := &vmFields{
+			hasNumbers:         frozen.hasNumbers,
+			isNondeterministic: frozen.isNondeterministic,
+			numericStrings:     frozen.numericStrings,
+			exactIntegers:      frozen.exactIntegers,
+			hasNonLiterals:     frozen.hasNonLiterals,
+		}
+		if frozen.start >= 0 {
+			fields.start = states[frozen.start]
+		}
+		if frozen.singletonMatch.len >= 0 {
+			fields.singletonMatch = slices.Clone(fz.bytesAt(frozen.singletonMatch))
+			fields.singletonTransition = fms[frozen.singletonTransition]
+		}
+		for _, p := range fz.predicates[frozen.predicates.off : frozen.predicates.off+frozen.predicates.len] {
+			fields.predicates = append(fields.predicates,
+				predicateTransition{vType: p.vType, name: p.name, predicate: p.predicate, next: fms[p.next]})
+		}
+		if frozen.literals >= 0 {
+			fl := fz.literals[frozen.literals]
+			dawg := &literalDAWG{root: fl.root, nodes: fl.nodes, edges: fl.edges}
+			for _, s := range fl.transitions {
+				dawg.transitions = append(dawg.transitions, thawFieldMatchers(s))
+			}
+			fields.literals = dawg
+		}
+		vms[i] = &valueMatcher{}
+		vms[i].update(fields)
+	}
+
+	thawPathEdges := func(s span) map[string]*fieldMatcher {
+		m := make(map[string]*fieldMatcher, s.len)
+		for _, edge := range fz.pathEdges[s.off : s.off+s.len] {
+			m[string(fz.bytesAt(edge.path))] = fms[edge.target]
+		}
+		return m
+	}
+	for i, frozen := range fz.fms {
+		fields := &fmFields{
+			transitions:             make(map[string]*valueMatcher, frozen.transitions.len),
+			existsTrue:              thawPathEdges(frozen.existsTrue),
+			existsFalse:             thawPathEdges(frozen.existsFalse),
+			existsFalseIgnoringNull: thawPathEdges(frozen.existsFalseIgnoringNull),
+			equalsField:             make(map[string]map[string]*fieldMatcher),
+		}
+		for _, edge := range fz.pathEdges[frozen.transitions.off : frozen.transitions.off+frozen.transitions.len] {
+			fields.transitions[string(fz.bytesAt(edge.path))] = vms[edge.target]
+		}
+		if frozen.matches.len > 0 {
+			fields.matches = slices.Clone(fz.xs[frozen.matches.off : frozen.matches.off+frozen.matches.len])
+		}
+		for _, edge := range fz.equalsEdges[frozen.equalsField.off : frozen.equalsField.off+frozen.equalsField.len] {
+			path := string(fz.bytesAt(edge.path))
+			refs, ok := fields.equalsField[path]
+			if !ok {
+				refs = make(map[string]*fieldMatcher)
+				fields.equalsField[path] = refs
+			}
+			refs[string(fz.bytesAt(edge.refPath))] = fms[edge.target]
+		}
+		fms[i].update(fields)
+	}
+	return fms[fz.root]
+}
+
+//
+// Merging
+//
+
+// mergeFieldMatchers returns a fieldMatcher which behaves like fm1 and fm2 combined. It is used to merge
+// an overlay into a thawed frozenMatcher; fm1 and fm2 must not be in use for matching. If log isn't nil,
+// the fieldMatchers and valueMatchers which are replaced by merged ones are recorded in it.
+func mergeFieldMatchers(fm1, fm2 *fieldMatcher, bufs *closureBuffers, buildMode MatcherBuildMode, log *mergeLog) *fieldMatcher {
+	f1, f2 := fm1.fields(), fm2.fields()
+	merged := &fmFields{
+		matches:                 append(slices.Clip(f1.matches), f2.matches...),
+		transitions:             make(map[string]*valueMatcher),
+		existsTrue:              mergeFMMaps(f1.existsTrue, f2.existsTrue, bufs, buildMode, log),
+		existsFalse:             mergeFMMaps(f1.existsFalse, f2.existsFalse, bufs, buildMode, log),
+		existsFalseIgnoringNull: mergeFMMaps(f1.existsFalseIgnoringNull, f2.existsFalseIgnoringNull, bufs, buildMode, log),
+		equalsField:             make(map[string]map[string]*fieldMatcher),
+	}
+	for path, vm := range f1.transitions {
+		merged.transitions[path] = vm
+	}
+	for path, vm2 := range f2.transitions {
+		if vm1, ok := merged.transitions[path]; ok {
+			merged.transitions[path] = mergeValueMatchers(vm1, vm2, bufs, buildMode, log)
+		} else {
+			merged.transitions[path] = vm2
+		}
+	}
+	for path, refs := range f1.equalsField {
+		merged.equalsField[path] = refs
+	}
+	for path, refs2 := range f2.equalsField {
+		merged.equalsField[path] = mergeFMMaps(merged.equalsField[path], refs2, bufs, buildMode, log)
+	}
+	fm := &fieldMatcher{}
+	fm.update(merged)
+	log.fieldMatcher(fm1, fm2, fm)
+	return fm
+}
+
+func mergeFMMaps(m1, m2 map[string]*fieldMatcher, bufs *closureBuffers, buildMode MatcherBuildMode, log *mergeLog) map[string]*fieldMatcher {
+	merged := make(map[string]*fieldMatcher, len(m1)+len(m2))
+	for path, fm := range m1 {
+		merged[path] = fm
+	}
+	for path, fm2 := range m2 {
+		if fm1, ok := merged[path]; ok {
+			merged[path] = mergeFieldMatchers(fm1, fm2, bufs, buildMode, log)
+		} else {
+			merged[path] = fm2
+		}
+	}
+	return merged
+}
+
+// mergeValueMatchers returns a valueMatcher which behaves like vm1 and vm2 combined. The automata are
+// merged in the same way as AddPattern merges a new pattern's automaton; the fieldMatchers they lead to
+// are left alone.
+func mergeValueMatchers(vm1, vm2 *valueMatcher, bufs *closureBuffers, buildMode MatcherBuildMode, log *mergeLog) *valueMatcher {
+	f1, f2 := vm1.fields(), vm2.fields()
+	merged := &vmFields{
+		hasNumbers:         f1.hasNumbers || f2.hasNumbers,
+		isNondeterministic: f1.isNondeterministic || f2.isNondeterministic,
+		numericStrings:     f1.numericStrings || f2.numericStrings,
+		exactIntegers:      f1.exactIntegers || f2.exactIntegers,
+		hasNonLiterals:     f1.hasNonLiterals || f2.hasNonLiterals,
+		predicates:         append(slices.Clip(f1.predicates), f2.predicates...),
+	}
+
+	// the same string value leads to the same fieldMatcher, as it would if the patterns had been added in turn
+	if f1.start == nil && f2.start == nil && f1.singletonMatch != nil && bytes.Equal(f1.singletonMatch, f2.singletonMatch) {
+		merged.singletonMatch = f1.singletonMatch
+		merged.singletonTransition = mergeFieldMatchers(f1.singletonTransition, f2.singletonTransition, bufs, buildMode, log)
+		merged.literals = mergeLiterals(f1.literals, f2.literals)
+		vm := &valueMatcher{}
+		vm.update(merged)
+		log.valueMatcher(vm1, vm2, vm)
+		return vm
+	}
+
+	var automata []*faState
+	for _, fields := range []*vmFields{f1, f2} {
+		if fields.start != nil {
+			automata = append(automata, fields.start)
+		}
+	}
+	singletons := 0
+	for _, fields := range []*vmFields{f1, f2} {
+		if fields.singletonMatch != nil {
+			singletons++
+			merged.singletonMatch, merged.singletonTransition = fields.singletonMatch, fields.singletonTransition
+		}
+	}
+	if len(automata) > 0 || singletons > 1 {
+		// the singletons have to go in the automaton
+		for _, fields := range []*vmFields{f1, f2} {
+			if fields.singletonMatch != nil {
+				table, _ := makeStringFA(fields.singletonMatch, fields.singletonTransition, false)
+				automata = append(automata, &faState{table: table})
+			}
+		}
+		merged.singletonMatch, merged.singletonTransition = nil, nil
+		merged.start = automata[0]
+		for _, automaton := range automata[1:] {
+			merged.start = mergeStartStates(merged.start, automaton, sharedNullPrinter)
+		}
+		merged.prepareAutomaton(bufs, buildMode)
+	}
+
+	merged.literals = mergeLiterals(f1.literals, f2.literals)
+
+	vm := &valueMatcher{}
+	vm.update(merged)
+	log.valueMatcher(vm1, vm2, vm)
+	return vm
+}
+
+func mergeLiterals(d1, d2 *literalDAWG) *literalDAWG {
+	switch {
+	case d1 != nil && d2 != nil:
+		values := append(d1.values(), d2.values()...)
+		slices.SortFunc(values, compareLiteralValues)
+		return newLiteralDAWG(mergeLiteralValues(values))
+	case d1 != nil:
+		return d1
+	default:
+		return d2
+	}
+}
+
+// mergeLiteralValues combines the transitions of adjacent equal values in a sorted slice, in place
+func mergeLiteralValues(values []literalValue) []literalValue {
+	merged := values[:0]
+	for _, value := range values {
+		last := len(merged) - 1
+		if last >= 0 && bytes.Equal(merged[last].val, value.val) {
+			merged[last].transitions = dedupFieldTransitions(append(merged[last].transitions, value.transitions...))
+			continue
+		}
+		merged = append(merged, value)
+	}
+	return merged
+}
+
+//
+// Matching
+//
+
+// frozenBuffers is the frozenMatcher equivalent of the parts of nfaBuffers used in traversal. As with
+// transmap, the results of transitionOn are stacked, because tryToMatch iterates over them while
+// recursing.
+type frozenBuffers struct {
+	levels     [][]int32
+	depth      int
+	buf1, buf2 []int32
+	fieldSet   map[int32]bool
+	fieldMatch fieldMatch[int32]
+}
+
+func (nb *nfaBuffers) getFrozenBuffers() *frozenBuffers {
+	if nb.frozen == nil {
+		nb.frozen = &frozenBuffers{fieldSet: make(map[int32]bool), depth: -1}
+	}
+	return nb.frozen
+}
+
+func (fb *frozenBuffers) push() {
+	fb.depth++
+	for fb.depth >= len(fb.levels) {
+		fb.levels = append(fb.levels, make([]int32, 0, 16))
+	}
+	fb.levels[fb.depth] = fb.levels[fb.depth][:0]
+}
+
+func (fb *frozenBuffers) pop() {
+	fb.depth--
+}
+
+// matchesForFields is the frozen equivalent of the loop in unfilteredMatchesForFields; the fields must be
+// sorted. A frozenMatcher is the fieldAutomaton for its own fieldMatchers.
+func (fz *frozenMatcher) matchesForFields(fields []Field, matches *matchSet, bufs *nfaBuffers) {
+	fb := bufs.getFrozenBuffers()
+	fb.depth = -1
+	fb.fieldMatch.matchAll(fz, fz.root, fields, matches, bufs)
+}
+
+// findEdge finds the edge for path among edges, which are sorted by path
+func (fz *frozenMatcher) findEdge(edges []frozenPathEdge, path []byte) (int32, bool) {
+	i, found := slices.BinarySearchFunc(edges, path, func(edge frozenPathEdge, path []byte) int {
+		return bytes.Compare(fz.bytesAt(edge.path), path)
+	})
+	if !found {
+		return 0, false
+	}
+	return edges[i].target, true
+}
+
+func (fz *frozenMatcher) edgesIn(s span) []frozenPathEdge {
+	return fz.pathEdges[s.off : s.off+s.len]
+}
+
+func (fz *frozenMatcher) matchesOf(fm int32) []X {
+	s := fz.fms[fm].matches
+	return fz.xs[s.off : s.off+s.len]
+}
+
+func (fz *frozenMatcher) existsTrueOn(fm int32, path []byte) (int32, bool) {
+	return fz.findEdge(fz.edgesIn(fz.fms[fm].existsTrue), path)
+}
+
+func (fz *frozenMatcher) eachExistsFalse(fm int32, index int, m *fieldMatch[int32]) {
+	frozen := &fz.fms[fm]
+	for _, edge := range fz.edgesIn(frozen.existsFalse) {
+		m.tryExistsFalse(index, fz.stringAt(edge.path), edge.target, false)
+	}
+	for _, edge := range fz.edgesIn(frozen.existsFalseIgnoringNull) {
+		m.tryExistsFalse(index, fz.stringAt(edge.path), edge.target, true)
+	}
+}
+
+func (fz *frozenMatcher) eachEqualsField(fm int32, index int, m *fieldMatch[int32]) {
+	s := fz.fms[fm].equalsField
+	for _, edge := range fz.equalsEdges[s.off : s.off+s.len] {
+		if bytes.Equal(fz.bytesAt(edge.path), m.fields[index].Path) {
+			m.tryEqualsField(index, fz.stringAt(edge.refPath), edge.target)
+		}
+	}
+}
+
+func (fz *frozenMatcher) pushTransitions(bufs *nfaBuffers) {
+	bufs.getFrozenBuffers().push()
+}
+
+func (fz *frozenMatcher) popTransitions(bufs *nfaBuffers) {
+	bufs.getFrozenBuffers().pop()
+}
+
+// transitionOn is the frozen equivalent of fieldMatcher.transitionOn and valueMatcher.transitionOn. It
+// returns its results in the current level of the frozenBuffers.
+func (fz *frozenMatcher) transitionOn(fm int32, field *Field, bufs *nfaBuffers) []int32 {
+	vmIndex, ok := fz.findEdge(fz.edgesIn(fz.fms[fm].transitions), field.Path)
+	if !ok {
+		return nil
+	}
+	vm := &fz.vms[vmIndex]
+	fb := bufs.getFrozenBuffers()
+	transitions := fb.levels[fb.depth][:0]
+
+	val := field.Val
+	switch {
+	case vm.singletonMatch.len >= 0:
+		if bytes.Equal(fz.bytesAt(vm.singletonMatch), val) {
+			transitions = append(transitions, vm.singletonTransition)
+		}
+	case vm.start >= 0:
+		transitions = fz.traverseValue(vm, field, transitions, bufs)
+	}
+
+	for _, p := range fz.predicates[vm.predicates.off : vm.predicates.off+vm.predicates.len] {
+		if p.predicate(val) {
+			transitions = append(transitions, p.next)
+		}
+	}
+	if vm.literals >= 0 {
+		fl := &fz.literals[vm.literals]
+		dawg := literalDAWG{root: fl.root, nodes: fl.nodes, edges: fl.edges}
+		if index, ok := dawg.index(val); ok {
+			s := fl.transitions[index]
+			transitions = append(transitions, fz.fmRefs[s.off:s.off+s.len]...)
+		}
+	}
+	fb.levels[fb.depth] = transitions
+	return transitions
+}
+
+// traverseValue is the frozen equivalent of traverseValueFA
+func (fz *frozenMatcher) traverseValue(vm *frozenValueMatcher, field *Field, transitions []int32, bufs *nfaBuffers) []int32 {
+	val := field.Val
+	if vm.hasNumbers && field.IsNumber {
+		qNum, err := numberForm(vm.exactIntegers, val, &bufs.qNumBuf)
+		if err == nil {
+			return fz.traverse(vm, qNum, transitions, bufs)
+		}
+	}
+	if vm.hasNumbers && vm.numericStrings && len(val) > 2 && val[0] == '"' && isJSONNumber(val[1:len(val)-1]) {
+		qNum, err := numberForm(vm.exactIntegers, val[1:len(val)-1], &bufs.qNumBuf)
+		if err == nil {
+			transitions = fz.traverse(vm, qNum, transitions, bufs)
+		}
+	}
+	return fz.traverse(vm, val, transitions, bufs)
+}
+
+func (fz *frozenMatcher) traverse(vm *frozenValueMatcher, val []byte, transitions []int32, bufs *nfaBuffers) []int32 {
+	switch {
+	case vm.isNondeterministic && vm.lazyDFA >= 0:
+		return fz.traverseLazyDFA(fz.lazyDFAFor(vm), val, transitions, bufs)
+	case vm.isNondeterministic:
+		return fz.traverseNFA(vm.start, val, transitions, bufs.getFrozenBuffers())
+	}
+	return fz.traverseDFA(vm.start, val, transitions)
+}
+
+// step is the frozen equivalent of smallTable.step
+func (fz *frozenMatcher) step(state int32, utf8Byte byte) int32 {
+	table := fz.states[state].table
+	for i, ceiling := range fz.ceilings[table.off : table.off+table.len] {
+		if utf8Byte < ceiling {
+			return fz.steps[table.off+int32(i)]
+		}
+	}
+	return -1
+}
+
+func (fz *frozenMatcher) fieldTransitions(state int32) []int32 {
+	s := fz.states[state].transitions
+	return fz.fmRefs[s.off : s.off+s.len]
+}
+
+// traverseDFA is the frozen equivalent of the traverseDFA function
+func (fz *frozenMatcher) traverseDFA(state int32, val []byte, transitions []int32) []int32 {
+	for index := 0; index <= len(val); index++ {
+		utf8Byte := valueTerminator
+		if index < len(val) {
+			utf8Byte = val[index]
+		}
+		state = fz.step(state, utf8Byte)
+		if state < 0 {
+			break
+		}
+		transitions = append(transitions, fz.fieldTransitions(state)...)
+	}
+	return transitions
+}
+
+// traverseNFA is the frozen equivalent of the traverseNFA function
+func (fz *frozenMatcher) traverseNFA(start int32, val []byte, transitions []int32, fb *frozenBuffers) []int32 {
+	currentStates := append(fb.buf1[:0], start)
+	nextStates := fb.buf2[:0]
+	fieldSet := fb.fieldSet
+	clear(fieldSet)
+	for _, fm := range transitions {
+		fieldSet[fm] = true
+	}
+
+	for index := 0; len(currentStates) != 0 && index <= len(val); index++ {
+		utf8Byte := valueTerminator
+		if index < len(val) {
+			utf8Byte = val[index]
+		}
+		for _, state := range currentStates {
+			closure := fz.states[state].closure
+			if closure.len <= 0 {
+				// self-only closure
+				fz.addFieldTransitions(state, fieldSet)
+				if next := fz.step(state, utf8Byte); next >= 0 {
+					nextStates = append(nextStates, next)
+				}
+				continue
+			}
+			for _, ecState := range fz.stateRefs[closure.off : closure.off+closure.len] {
+				fz.addFieldTransitions(ecState, fieldSet)
+				if next := fz.step(ecState, utf8Byte); next >= 0 {
+					nextStates = append(nextStates, next)
+				}
+			}
+		}
+		currentStates, nextStates = nextStates, currentStates[:0]
+	}
+
+	// out of input bytes, check the current states and their closures for matches
+	for _, state := range currentStates {
+		closure := fz.states[state].closure
+		if closure.len <= 0 {
+			fz.addFieldTransitions(state, fieldSet)
+			continue
+		}
+		for _, ecState := range fz.stateRefs[closure.off : closure.off+closure.len] {
+			fz.addFieldTransitions(ecState, fieldSet)
+		}
+	}
+	fb.buf1, fb.buf2 = currentStates[:0], nextStates[:0]
+
+	transitions = transitions[:0]
+	for fm := range fieldSet {
+		transitions = append(transitions, fm)
+	}
+	return transitions
+}
+
+func (fz *frozenMatcher) addFieldTransitions(state int32, fieldSet map[int32]bool) {
+	for _, fm := range fz.fieldTransitions(state) {
+		fieldSet[fm] = true
+	}
+}
+
+// frozenLazyDFA is the frozen equivalent of lazyDFA. It is created the first time a valueMatcher's automaton
+// is traversed, and its states are charged to the same budget as those of the lazyDFAs in the overlay.
+type frozenLazyDFA struct {
+	lock       sync.Mutex
+	fz         *frozenMatcher
+	nfaStart   int32
+	start      atomic.Pointer[frozenLazyState]
+	states     map[string]*frozenLazyState
+	keyBuf     []byte
+	rawBuf     []int32
+	generation uint64
+}
+
+type frozenLazyState struct {
+	nfaStates        []int32
+	fieldTransitions []int32
+	next             [256]atomic.Pointer[frozenLazyState]
+}
+
+var frozenLazyDeadState = &frozenLazyState{}
+
+// lazyDFAFor returns vm's frozenLazyDFA, creating it if this is the first traversal to need it
+func (fz *frozenMatcher) lazyDFAFor(vm *frozenValueMatcher) *frozenLazyDFA {
+	slot := &fz.lazyDFAs[vm.lazyDFA]
+	if dfa := slot.Load(); dfa != nil {
+		return dfa
+	}
+	dfa := &frozenLazyDFA{fz: fz, nfaStart: vm.start, states: make(map[string]*frozenLazyState)}
+	dfa.lock.Lock()
+	dfa.start.Store(dfa.stateFor([]int32{vm.start}))
+	dfa.lock.Unlock()
+	if !slot.CompareAndSwap(nil, dfa) {
+		return slot.Load()
+	}
+	return dfa
+}
+
+// stateFor is the frozen equivalent of lazyDFA.stateFor. It must be called with the lock held.
+func (dfa *frozenLazyDFA) stateFor(rawNStates []int32) *frozenLazyState {
+	fz := dfa.fz
+	nStates := make([]int32, 0, len(rawNStates))
+	for _, rawNState := range rawNStates {
+		closure := fz.states[rawNState].closure
+		if closure.len <= 0 {
+			nStates = append(nStates, rawNState) // self-only closure
+		} else {
+			nStates = append(nStates, fz.stateRefs[closure.off:closure.off+closure.len]...)
+		}
+	}
+	slices.Sort(nStates)
+	nStates = slices.Compact(nStates)
+	key := dfa.keyBuf[:0]
+	for _, nState := range nStates {
+		key = append(key, byte(nState), byte(nState>>8), byte(nState>>16), byte(nState>>24))
+	}
+	dfa.keyBuf = key
+	if state, ok := dfa.states[string(key)]; ok {
+		return state
+	}
+
+	state := &frozenLazyState{nfaStates: nStates}
+	seen := make(map[int32]bool)
+	for _, nState := range nStates {
+		for _, fm := range fz.fieldTransitions(nState) {
+			if !seen[fm] {
+				seen[fm] = true
+				state.fieldTransitions = append(state.fieldTransitions, fm)
+			}
+		}
+	}
+	dfa.states[string(key)] = state
+	return state
+}
+
+// step is the frozen equivalent of lazyDFA.step
+func (dfa *frozenLazyDFA) step(from *frozenLazyState, utf8Byte byte, budget *lazyDFABudget) *frozenLazyState {
+	next, built := dfa.stepWhileLocked(from, utf8Byte, budget)
+	if built && budget != nil {
+		budget.charge()
+	}
+	return next
+}
+
+func (dfa *frozenLazyDFA) stepWhileLocked(from *frozenLazyState, utf8Byte byte, budget *lazyDFABudget) (*frozenLazyState, bool) {
+	dfa.lock.Lock()
+	defer dfa.lock.Unlock()
+
+	if next := from.next[utf8Byte].Load(); next != nil {
+		return next, false
+	}
+	if budget != nil && dfa.generation != budget.generation.Load() {
+		dfa.generation = budget.join(dfa)
+	}
+	rawStates := dfa.rawBuf[:0]
+	for _, nState := range from.nfaStates {
+		if next := dfa.fz.step(nState, utf8Byte); next >= 0 {
+			rawStates = append(rawStates, next)
+		}
+	}
+	dfa.rawBuf = rawStates
+	next := frozenLazyDeadState
+	cached := len(dfa.states)
+	if len(rawStates) > 0 {
+		next = dfa.stateFor(rawStates)
+	}
+	from.next[utf8Byte].Store(next)
+	return next, len(dfa.states) > cached
+}
+
+func (dfa *frozenLazyDFA) flush() {
+	dfa.lock.Lock()
+	defer dfa.lock.Unlock()
+	clear(dfa.states)
+	dfa.start.Store(dfa.stateFor([]int32{dfa.nfaStart}))
+}
+
+// traverseLazyDFA is the frozen equivalent of the traverseLazyDFA function
+func (fz *frozenMatcher) traverseLazyDFA(dfa *frozenLazyDFA, val []byte, transitions []int32, bufs *nfaBuffers) []int32 {
+	fieldSet := bufs.getFrozenBuffers().fieldSet
+	clear(fieldSet)
+	for _, fm := range transitions {
+		fieldSet[fm] = true
+	}
+
+	state := dfa.start.Load()
+	for _, fm := range state.fieldTransitions {
+		fieldSet[fm] = true
+	}
+	for index := 0; index <= len(val); index++ {
+		utf8Byte := valueTerminator
+		if index < len(val) {
+			utf8Byte = val[index]
+		}
+		next := state.next[utf8Byte].Load()
+		if next == nil {
+			next = dfa.step(state, utf8Byte, bufs.lazyDFAs)
+		}
+		if next == frozenLazyDeadState {
+			break
+		}
+		for _, fm := range next.fieldTransitions {
+			fieldSet[fm] = true
+		}
+		state = next
+	}
+
+	transitions = transitions[:0]
+	for fm := range fieldSet {
+		transitions = append(transitions, fm)
+	}
+	return transitions
+}
+
+// memoryCost reports the bytes used by the frozenMatcher's slices
+func (fz *frozenMatcher) memoryCost() int64 {
+	cost := int64(unsafe.Sizeof(*fz))
+	cost += int64(cap(fz.fms)) * int64(unsafe.Sizeof(frozenFieldMatcher{}))
+	cost += int64(cap(fz.vms)) * int64(unsafe.Sizeof(frozenValueMatcher{}))
+	cost += int64(cap(fz.states)) * int64(unsafe.Sizeof(frozenState{}))
+	cost += int64(cap(fz.ceilings))
+	cost += 4 * int64(cap(fz.steps)+cap(fz.stateRefs)+cap(fz.fmRefs))
+	cost += int64(cap(fz.pathEdges)) * int64(unsafe.Sizeof(frozenPathEdge{}))
+	cost += int64(cap(fz.equalsEdges)) * int64(unsafe.Sizeof(frozenEqualsEdge{}))
+	cost += int64(cap(fz.arena))
+	cost += int64(cap(fz.xs)) * int64(unsafe.Sizeof(X(nil)))
+	cost += int64(cap(fz.predicates)) * int64(unsafe.Sizeof(frozenPredicate{}))
+	cost += int64(cap(fz.lazyDFAs)) * mcPointer
+	for _, fl := range fz.literals {
+		cost += int64(unsafe.Sizeof(fl))
+		cost += int64(cap(fl.nodes)) * int64(unsafe.Sizeof(dawgNode{}))
+		cost += int64(cap(fl.edges)) * int64(unsafe.Sizeof(dawgEdge{}))
+		cost += int64(cap(fl.transitions)) * int64(unsafe.Sizeof(span{}))
+	}
+	return cost
+}
@@ -0,0 +1,264 @@
+package quamina
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+var registerFrozenOperator sync.Once
+
+func frozenTestPatterns() []string {
+	registerFrozenOperator.Do(func() {
+		_ = RegisterOperator("test-frozen-even", Operator{Predicate: func(val []byte) bool {
+			return len(val) > 0 && (val[len(val)-1]-'0')%2 == 0
+		}})
+	})
+	patterns := []string{
+		`{"a": ["x", "y", 3]}`,
+		`{"a": [{"exists": true}], "b": ["z"]}`,
+		`{"a": [{"exists": false}], "b": ["z"]}`,
+		`{"b": [{"shellstyle": "*z*"}]}`,
+		`{"b": [{"wildcard": "q*r"}], "c": [{"exists": false}]}`,
+		`{"c": [{"regexp": "[a-c]+(x|y)"}]}`,
+		`{"n": [50, 5e1, 10]}`,
+		`{"n": [35, 1e3]}`,
+		`{"n": [{"test-frozen-even": true}]}`,
+		`{"s": [{"anything-but": ["s1", "s2"]}]}`,
+		`{"s": [{"prefix": "s"}], "t": [{"equals-field": "s"}]}`,
+		`{"arr": {"k": ["v1"], "l": ["v2"]}}`,
+		`{"s": [{"equals-ignore-case": "SX"}]}`,
+	}
+	for i := 0; i < 40; i++ {
+		patterns = append(patterns, fmt.Sprintf(`{"host": ["h%d.example.com"]}`, i))
+	}
+	return patterns
+}
+
+func frozenTestEvents() []string {
+	events := []string{
+		`{"a": "x"}`, `{"a": 3}`, `{"a": "q", "b": "z"}`, `{"b": "z"}`, `{"b": "azb"}`, `{"b": "qqr"}`,
+		`{"b": "qr", "c": 1}`, `{"c": "abcx"}`, `{"c": "dx"}`, `{"n": 50}`, `{"n": 35}`, `{"n": 1000}`,
+		`{"n": 8}`, `{"n": 7}`, `{"s": "s1"}`, `{"s": "s3", "t": "s3"}`, `{"s": "sx", "t": "sy"}`,
+		`{"arr": [{"k": "v1", "l": "v2"}]}`, `{"arr": [{"k": "v1"}, {"l": "v2"}]}`, `{}`, `{"other": 1}`,
+		`{"s": "sX"}`, `{"host": "h17.example.com"}`, `{"host": "h99.example.com"}`, `{"host": "h170.example.com"}`,
+	}
+	return events
+}
+
+func TestFreeze(t *testing.T) {
+	patterns := frozenTestPatterns()
+	events := frozenTestEvents()
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		reference, _ := New()
+		_ = reference.SetMatcherBuildMode(mode)
+		frozen, _ := New()
+		_ = frozen.SetMatcherBuildMode(mode)
+		add := func(q *Quamina, from, to int) {
+			for i := from; i < to; i++ {
+				if err := q.AddPattern(i, patterns[i]); err != nil {
+					t.Fatalf("%s: %s", patterns[i], err.Error())
+				}
+			}
+		}
+		check := func(when string) {
+			t.Helper()
+			for _, event := range events {
+				want, _ := reference.MatchesForEvent([]byte(event))
+				got, err := frozen.MatchesForEvent([]byte(event))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				if !sameXs(want, got) {
+					t.Errorf("mode %d, %s, %s: wanted %v got %v", mode, when, event, want, got)
+				}
+			}
+		}
+
+		// some patterns frozen, then others in the overlay, then all frozen
+		half := len(patterns) / 2
+		add(reference, 0, half)
+		add(frozen, 0, half)
+		frozen.Freeze()
+		check("frozen")
+		add(reference, half, len(patterns))
+		add(frozen, half, len(patterns))
+		check("overlay")
+		frozen.Freeze()
+		check("refrozen")
+
+		// patterns which collide with frozen ones in every kind of transition
+		for i, pattern := range patterns {
+			x := fmt.Sprintf("again%d", i)
+			if err := reference.AddPattern(x, pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+			if err := frozen.AddPattern(x, pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		check("colliding overlay")
+		frozen.Compact()
+		frozen.Freeze()
+		check("colliding refrozen")
+	}
+}
+
+func TestFreezeCompacted(t *testing.T) {
+	patterns := frozenTestPatterns()
+	reference, _ := New()
+	frozen, _ := New()
+	for i, pattern := range patterns {
+		if err := reference.AddPattern(i, pattern); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := frozen.AddPattern(i, pattern); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	frozen.Compact()
+	frozen.Freeze()
+	if err := frozen.AddPattern("new", `{"host": ["h1000.example.com"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	frozen.Freeze()
+	matches, _ := frozen.MatchesForEvent([]byte(`{"host": "h1000.example.com"}`))
+	if !sameXs(matches, []X{"new"}) {
+		t.Errorf("h1000 matched %v", matches)
+	}
+	for _, event := range frozenTestEvents() {
+		want, _ := reference.MatchesForEvent([]byte(event))
+		got, _ := frozen.MatchesForEvent([]byte(event))
+		if !sameXs(want, got) {
+			t.Errorf("%s: wanted %v got %v", event, want, got)
+		}
+	}
+}
+
+func TestFreezeExclusions(t *testing.T) {
+	q, _ := New()
+	if err := q.AddPattern("p", `{"a": [{"prefix": "x"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	q.Freeze()
+	if err := q.AddExclusionPattern("p", `{"a": ["xy"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	check := func(when string) {
+		t.Helper()
+		matches, _ := q.MatchesForEvent([]byte(`{"a": "xz"}`))
+		if !sameXs(matches, []X{"p"}) {
+			t.Errorf("%s: xz matched %v", when, matches)
+		}
+		matches, _ = q.MatchesForEvent([]byte(`{"a": "xy"}`))
+		if len(matches) != 0 {
+			t.Errorf("%s: xy matched %v", when, matches)
+		}
+	}
+	check("overlay")
+	q.Freeze()
+	check("frozen")
+}
+
+func TestFreezeThaw(t *testing.T) {
+	cm := newCoreMatcher()
+	for i, pattern := range frozenTestPatterns() {
+		if err := cm.addPattern(i, pattern, BuiltForComfort); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	fz := freezeFieldMatcher(cm.fields().state)
+	again := freezeFieldMatcher(fz.thaw())
+	if len(again.fms) != len(fz.fms) || len(again.vms) != len(fz.vms) || len(again.states) != len(fz.states) ||
+		len(again.arena) != len(fz.arena) || len(again.xs) != len(fz.xs) {
+		t.Errorf("thawing and refreezing changed the automaton")
+	}
+}
+
+func TestFreezeMemory(t *testing.T) {
+	q, _ := New()
+	for i := 0; i < 1000; i++ {
+		pattern := fmt.Sprintf(`{"a": ["v%d"], "b": [{"shellstyle": "*%d"}]}`, i, i)
+		if err := q.AddPattern(i, pattern); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	before := q.GetMatcherStats()
+	q.Freeze()
+	after := q.GetMatcherStats()
+	if after["states"] != before["states"] {
+		t.Errorf("%.0f states became %.0f", before["states"], after["states"])
+	}
+	if after["bytes"] >= before["bytes"] {
+		t.Errorf("freezing didn't shrink %.0f bytes, got %.0f", before["bytes"], after["bytes"])
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"a": "v17", "b": "x17"}`))
+	if !sameXs(matches, []X{17}) {
+		t.Errorf("matched %v", matches)
+	}
+}
+
+func TestFreezeLazily(t *testing.T) {
+	q, _ := New(WithXCodec(testXCodec{}))
+	_ = q.SetMatcherBuildMode(BuiltLazily)
+	for i := 0; i < 20; i++ {
+		if err := q.AddPattern(i, fmt.Sprintf(`{"x": [{"shellstyle": "*%d*"}], "y": [{"regexp": "a+%d"}]}`, i, i)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	q.Freeze()
+	cm := q.matcher.(*coreMatcher)
+	// small enough that the goroutines flush each other's caches
+	cm.lazyDFAs = newLazyDFABudget(16)
+	fz := cm.fields().frozen
+	// one for x, and one for y after each of x's values
+	if len(fz.lazyDFAs) != 21 {
+		t.Fatalf("%d lazy DFA slots", len(fz.lazyDFAs))
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(q *Quamina) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				event := fmt.Sprintf(`{"x": "q%dq", "y": "aaa%d"}`, i, i)
+				matches, _ := q.MatchesForEvent([]byte(event))
+				want := 0
+				if i < 20 {
+					want = 1
+				}
+				if len(matches) != want {
+					t.Errorf("%s: matched %v", event, matches)
+				}
+			}
+		}(q.Copy())
+	}
+	wg.Wait()
+	for i := range fz.lazyDFAs {
+		if fz.lazyDFAs[i].Load() == nil {
+			t.Error("lazy DFA not built")
+		}
+	}
+	if cm.lazyDFAs.flushes.Load() == 0 {
+		t.Error("cache never flushed")
+	}
+
+	// restoring in BuiltLazily mode also converts lazily
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	restored, _ := New(WithXCodec(testXCodec{}))
+	_ = restored.SetMatcherBuildMode(BuiltLazily)
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(restored.matcher.(*coreMatcher).fields().frozen.lazyDFAs) != 21 {
+		t.Error("restored matcher doesn't convert lazily")
+	}
+	matches, _ := restored.MatchesForEvent([]byte(`{"x": "q7q", "y": "a7"}`))
+	if !sameXs(matches, []X{7}) {
+		t.Errorf("matched %v", matches)
+	}
+}
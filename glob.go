@@ -0,0 +1,154 @@
+package quamina
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// The "glob" pattern, e.g. {"glob": "/var/log/*.[0-9]"}, provides the file-path and hostname matching syntax
+// familiar from shells:
+//   - "*" matches any sequence of characters, including none
+//   - "?" matches any single character
+//   - "[...]" matches any single character in the bracket class, which may contain characters and ranges such
+//     as "a-z". If the first character after "[" is "!" or "^", the class is negated. A "]" immediately after
+//     the "[", "[!", or "[^" is part of the class, as is a "-" at the beginning or end.
+//   - "\" makes the following character match itself, e.g. "\*" or "\["
+//
+// Matching is by Unicode characters, not bytes. A glob is translated into the same parse tree that the regexp
+// reader produces, using RuneRange for the bracket classes, and from there to an automaton by makeRegexpNFA.
+
+// readGlobSpecial reads {"glob": "..."}
+func readGlobSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	t, err := pb.jd.Token()
+	if err != nil {
+		return
+	}
+	pathVals = valsIn
+
+	globString, ok := t.(string)
+	if !ok {
+		err = errors.New("value for 'glob' must be a string")
+		return
+	}
+	var tree regexpRoot
+	tree, err = parseGlob(globString)
+	if err != nil {
+		return
+	}
+	pathVals = append(pathVals, typedVal{vType: regexpType, parsedRegexp: tree})
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
+
+// globReader tracks progress through a glob
+type globReader struct {
+	glob  string
+	index int
+}
+
+func (g *globReader) atEnd() bool {
+	return g.index >= len(g.glob)
+}
+
+func (g *globReader) nextRune() (rune, error) {
+	if g.atEnd() {
+		return 0, fmt.Errorf("glob %q: unexpected end", g.glob)
+	}
+	r, length := utf8.DecodeRuneInString(g.glob[g.index:])
+	if r == utf8.RuneError && length < 2 {
+		return 0, fmt.Errorf("glob %q: UTF-8 encoding error at offset %d", g.glob, g.index)
+	}
+	g.index += length
+	return r, nil
+}
+
+// parseGlob translates a glob into a single-branch regexp tree
+func parseGlob(glob string) (regexpRoot, error) {
+	g := &globReader{glob: glob}
+	var branch regexpBranch
+	for !g.atEnd() {
+		r, err := g.nextRune()
+		if err != nil {
+			return nil, err
+		}
+		qa := &quantifiedAtom{quantMin: 1, quantMax: 1}
+		switch r {
+		case '*':
+			// "**" means the same as "*"
+			if len(branch) > 0 && branch[len(branch)-1].isStar() {
+				continue
+			}
+			qa.dotRunes = true
+			qa.quantMin, qa.quantMax = 0, regexpNoMax
+		case '?':
+			qa.dotRunes = true
+		case '[':
+			qa.runes, err = readGlobClass(g)
+		case '\\':
+			r, err = g.nextRune()
+			qa.runes = RuneRange{{r, r}}
+		default:
+			qa.runes = RuneRange{{r, r}}
+		}
+		if err != nil {
+			return nil, err
+		}
+		branch = append(branch, qa)
+	}
+	return regexpRoot{branch}, nil
+}
+
+// readGlobClass reads a bracket class, starting after the "["
+func readGlobClass(g *globReader) (RuneRange, error) {
+	start := g.index - 1
+	var rr RuneRange
+	negated := false
+	if !g.atEnd() && (g.glob[g.index] == '!' || g.glob[g.index] == '^') {
+		negated = true
+		g.index++
+	}
+	first := true
+	for {
+		if g.atEnd() {
+			return nil, fmt.Errorf("glob %q: unterminated '[' at offset %d", g.glob, start)
+		}
+		lo, err := g.nextRune()
+		if err != nil {
+			return nil, err
+		}
+		if lo == ']' && !first {
+			break
+		}
+		first = false
+		if lo == '\\' {
+			if lo, err = g.nextRune(); err != nil {
+				return nil, err
+			}
+		}
+		hi := lo
+		// a range, unless the '-' is last in the class
+		if g.index+1 < len(g.glob) && g.glob[g.index] == '-' && g.glob[g.index+1] != ']' {
+			g.index++
+			if hi, err = g.nextRune(); err != nil {
+				return nil, err
+			}
+			if hi == '\\' {
+				if hi, err = g.nextRune(); err != nil {
+					return nil, err
+				}
+			}
+			if hi < lo {
+				return nil, fmt.Errorf("glob %q: invalid range %c-%c", g.glob, lo, hi)
+			}
+		}
+		rr = append(rr, RunePair{lo, hi})
+	}
+	rr = simplifyRuneRange(rr)
+	if negated {
+		rr = InvertRuneRange(rr)
+	}
+	return rr, nil
+}
@@ -0,0 +1,70 @@
+package quamina
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGlobMatching(t *testing.T) {
+	exerciseGlob(t, "*", []string{"", "*", "h", "hello", "日本"}, []string{})
+	exerciseGlob(t, "h?llo", []string{"hello", "hallo", "h日llo", "h?llo"}, []string{"hllo", "heello", "hello!"})
+	exerciseGlob(t, "*.log", []string{".log", "app.log", "a.b.log"}, []string{"app.log1", "applog", "app.lo"})
+	exerciseGlob(t, "/var/log/*.[0-9]", []string{"/var/log/syslog.1", "/var/log/x.9"}, []string{"/var/log/syslog.a", "/var/log/syslog.10", "/var/log/syslog"})
+	exerciseGlob(t, "host[0-9][0-9].example.com", []string{"host01.example.com", "host99.example.com"}, []string{"host1.example.com", "hostab.example.com", "host012.example.com"})
+	exerciseGlob(t, "[a-cx-z]", []string{"a", "b", "c", "x", "y", "z"}, []string{"d", "w", "A", "", "ab"})
+	exerciseGlob(t, "[!a-c]", []string{"d", "A", "日", "-"}, []string{"a", "b", "c", "", "dd"})
+	exerciseGlob(t, "[^a-c]", []string{"d", "A"}, []string{"a", "b", "c"})
+	exerciseGlob(t, "[]a]", []string{"]", "a"}, []string{"b", "[", "]a"})
+	exerciseGlob(t, "[!]]", []string{"a", "["}, []string{"]"})
+	exerciseGlob(t, "[-a]", []string{"-", "a"}, []string{"b"})
+	exerciseGlob(t, "[a-]", []string{"-", "a"}, []string{"b"})
+	exerciseGlob(t, "[日本]語", []string{"日語", "本語"}, []string{"語", "中語"})
+	exerciseGlob(t, `\*\?\[x]`, []string{"*?[x]"}, []string{"a?[x]", "*a[x]", "*?x"})
+	exerciseGlob(t, `[\]\\]`, []string{"]", `\`}, []string{"a"})
+	exerciseGlob(t, "a**b", []string{"ab", "axb", "axxb"}, []string{"a", "b", "ba"})
+	exerciseGlob(t, "*[0-9]?", []string{"1x", "abc12", "9日"}, []string{"1", "x1", "abc"})
+}
+
+func exerciseGlob(t *testing.T, glob string, yes []string, no []string) {
+	t.Helper()
+	globJSON, _ := json.Marshal(glob)
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		cm := newCoreMatcher()
+		err := cm.addPattern(glob, `{"x": [ {"glob": `+string(globJSON)+`}, "glob-literal"]}`, mode)
+		if err != nil {
+			t.Errorf("addPattern %s: %s", glob, err.Error())
+			return
+		}
+		for _, y := range append(yes, "glob-literal") {
+			yJSON, _ := json.Marshal(y)
+			matches, _ := cm.matchesForJSONEvent([]byte(`{"x": ` + string(yJSON) + `}`))
+			if len(matches) != 1 || matches[0] != glob {
+				t.Errorf("mode %d: [%s] doesn't match %s", mode, y, glob)
+			}
+		}
+		for _, n := range no {
+			nJSON, _ := json.Marshal(n)
+			matches, _ := cm.matchesForJSONEvent([]byte(`{"x": ` + string(nJSON) + `}`))
+			if len(matches) != 0 {
+				t.Errorf("mode %d: [%s] matches %s", mode, n, glob)
+			}
+		}
+	}
+}
+
+func TestGlobErrors(t *testing.T) {
+	bads := []string{
+		`{"x": [{"glob": 3}]}`,
+		`{"x": [{"glob": "abc\\"}]}`,
+		`{"x": [{"glob": "[abc"}]}`,
+		`{"x": [{"glob": "[]"}]}`,
+		`{"x": [{"glob": "[!]"}]}`,
+		`{"x": [{"glob": "[z-a]"}]}`,
+		`{"x": [{"glob": "[a-\\"}]}`,
+	}
+	for _, bad := range bads {
+		if _, err := patternFromJSON([]byte(bad)); err == nil {
+			t.Errorf("accepted %s", bad)
+		}
+	}
+}
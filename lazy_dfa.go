@@ -0,0 +1,235 @@
+package quamina
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// lazyDFAMaxStates bounds the number of DFA states cached by all the lazyDFAs in a matcher, not counting their
+// start states. Each costs a couple of kilobytes, mostly in its transition array, so this caps the memory they
+// use at a few tens of megabytes however many valueMatchers there are.
+const lazyDFAMaxStates = 16384
+
+// lazyDFA supports the BuiltLazily build mode. Rather than converting a whole NFA to a DFA at AddPattern
+// time, as nfa2Dfa does, it performs the subset construction one step at a time while events are being
+// matched, and caches the DFA states it builds so that later traversals along the same paths run at DFA
+// speed. As in nfa2Dfa, a DFA state is identified by the set of NFA states it represents.
+// The number of cached states is bounded by a lazyDFABudget which is shared by all the lazyDFAs in a matcher.
+// When it runs out, their caches are flushed and start filling again, so there's no way for a pathological
+// combination of patterns to consume unbounded memory; the worst case is that traversal proceeds at roughly
+// NFA speed.
+// A lazyDFA belongs to one version of a valueMatcher's automaton and is discarded with it when AddPattern
+// changes the automaton. Traversals read transitions with atomic loads and only take the lock when they
+// have to build a state, so any number of goroutines can use one concurrently.
+// generation is that of the budget when this lazyDFA was last added to its list; if it's out of date, the
+// cache has been flushed since.
+type lazyDFA struct {
+	lock       sync.Mutex
+	nfaStart   *faState
+	start      atomic.Pointer[lazyDFAState]
+	states     map[string]*lazyDFAState
+	keys       *stateLists
+	rawBuf     []*faState
+	generation uint64
+}
+
+// lazyDFABudget bounds the number of states cached by the lazyDFAs which share it, and by the frozenLazyDFAs
+// of a frozenMatcher. Those which have built states since the last flush are listed in caches, which is all
+// that the flush needs to visit. One which is discarded when AddPattern replaces its automaton is dropped
+// from the list by the next flush.
+type lazyDFABudget struct {
+	lock       sync.Mutex
+	maxStates  int64
+	states     atomic.Int64
+	generation atomic.Uint64
+	caches     []lazyCache
+	flushes    atomic.Int64
+}
+
+// lazyCache is implemented by lazyDFA and frozenLazyDFA. flush empties the cache, taking its lock.
+type lazyCache interface {
+	flush()
+}
+
+func newLazyDFABudget(maxStates int) *lazyDFABudget {
+	budget := &lazyDFABudget{maxStates: int64(max(maxStates, 1))}
+	budget.generation.Store(1)
+	return budget
+}
+
+// join adds cache to the list of those with states to flush, and returns the generation it has joined. It must
+// be called with the cache's lock held.
+func (budget *lazyDFABudget) join(cache lazyCache) uint64 {
+	budget.lock.Lock()
+	defer budget.lock.Unlock()
+	budget.caches = append(budget.caches, cache)
+	return budget.generation.Load()
+}
+
+// charge records that a state has been built, and if that uses up the budget, empties the caches which have
+// built states since the last flush. It mustn't be called with any cache's lock held, since flushing takes
+// each of them in turn.
+func (budget *lazyDFABudget) charge() {
+	if budget.states.Add(1) < budget.maxStates {
+		return
+	}
+	budget.lock.Lock()
+	if budget.states.Load() < budget.maxStates {
+		// another goroutine got here first
+		budget.lock.Unlock()
+		return
+	}
+	caches := budget.caches
+	budget.caches = nil
+	budget.generation.Add(1)
+	budget.states.Store(0)
+	budget.flushes.Add(1)
+	budget.lock.Unlock()
+
+	for _, cache := range caches {
+		cache.flush()
+	}
+}
+
+// lazyDFAState is a DFA state built by a lazyDFA. The next array is indexed by byte value; a nil entry means
+// the transition hasn't been computed yet, while lazyDeadState means it has and leads nowhere.
+type lazyDFAState struct {
+	nfaStates        []*faState
+	fieldTransitions []*fieldMatcher
+	next             [256]atomic.Pointer[lazyDFAState]
+}
+
+var lazyDeadState = &lazyDFAState{}
+
+// newLazyDFA relies upon epsilonClosure having been run on the NFA's start state.
+func newLazyDFA(nfaStart *faState) *lazyDFA {
+	dfa := &lazyDFA{
+		nfaStart: nfaStart,
+		states:   make(map[string]*lazyDFAState),
+		keys:     newStateLists(),
+	}
+	dfa.lock.Lock()
+	dfa.start.Store(dfa.stateFor([]*faState{nfaStart}))
+	dfa.lock.Unlock()
+	return dfa
+}
+
+// stateFor returns the DFA state for the set of NFA states which are reachable from the members of rawNStates
+// by following epsilon transitions, building it if necessary. It must be called with the lock held.
+func (dfa *lazyDFA) stateFor(rawNStates []*faState) *lazyDFAState {
+	nStates := make([]*faState, 0, len(rawNStates))
+	for _, rawNState := range rawNStates {
+		if len(rawNState.epsilonClosure) == 0 {
+			nStates = append(nStates, rawNState) // self-only closure: self is implicit
+		} else {
+			nStates = append(nStates, rawNState.epsilonClosure...) // includes self
+		}
+	}
+	dfa.keys.makeKey(nStates)
+	if state, ok := dfa.states[string(dfa.keys.keyBuf)]; ok {
+		return state
+	}
+
+	key := string(dfa.keys.keyBuf)
+	state := &lazyDFAState{nfaStates: make([]*faState, len(dfa.keys.sortBuf))}
+	copy(state.nfaStates, dfa.keys.sortBuf)
+	seen := make(map[*fieldMatcher]bool)
+	for _, nState := range state.nfaStates {
+		for _, fm := range nState.fieldTransitions {
+			if !seen[fm] {
+				seen[fm] = true
+				state.fieldTransitions = append(state.fieldTransitions, fm)
+			}
+		}
+	}
+	dfa.states[key] = state
+	return state
+}
+
+// step computes the transition from a DFA state on a byte value and records it in the state's next array. A
+// state it builds is charged to budget, which may be nil if the lazyDFA isn't part of a matcher.
+func (dfa *lazyDFA) step(from *lazyDFAState, utf8Byte byte, budget *lazyDFABudget) *lazyDFAState {
+	next, built := dfa.stepWhileLocked(from, utf8Byte, budget)
+	if built && budget != nil {
+		budget.charge()
+	}
+	return next
+}
+
+func (dfa *lazyDFA) stepWhileLocked(from *lazyDFAState, utf8Byte byte, budget *lazyDFABudget) (*lazyDFAState, bool) {
+	dfa.lock.Lock()
+	defer dfa.lock.Unlock()
+
+	// another goroutine may have got here first
+	if next := from.next[utf8Byte].Load(); next != nil {
+		return next, false
+	}
+	if budget != nil && dfa.generation != budget.generation.Load() {
+		dfa.generation = budget.join(dfa)
+	}
+	rawStates := dfa.rawBuf[:0]
+	for _, nState := range from.nfaStates {
+		if nextStep := nState.table.step(utf8Byte); nextStep != nil {
+			rawStates = append(rawStates, nextStep)
+		}
+	}
+	dfa.rawBuf = rawStates
+	next := lazyDeadState
+	cached := len(dfa.states)
+	if len(rawStates) > 0 {
+		next = dfa.stateFor(rawStates)
+	}
+	from.next[utf8Byte].Store(next)
+	return next, len(dfa.states) > cached
+}
+
+func (dfa *lazyDFA) flush() {
+	dfa.lock.Lock()
+	defer dfa.lock.Unlock()
+	// States from before the flush remain usable by traversals that are already in progress, but once those
+	// finish, nothing refers to them and they can be garbage-collected.
+	clear(dfa.states)
+	dfa.start.Store(dfa.stateFor([]*faState{dfa.nfaStart}))
+}
+
+// cachedStates reports the number of DFA states currently cached
+func (dfa *lazyDFA) cachedStates() int {
+	dfa.lock.Lock()
+	defer dfa.lock.Unlock()
+	return len(dfa.states)
+}
+
+// traverseLazyDFA is the BuiltLazily equivalent of traverseNFA, and like it, returns its results in the
+// current transmap buffer.
+func traverseLazyDFA(dfa *lazyDFA, val []byte, transitions []*fieldMatcher, bufs *nfaBuffers) []*fieldMatcher {
+	fieldSet := bufs.getFieldSet()
+	clear(fieldSet)
+	for _, fm := range transitions {
+		fieldSet[fm] = true
+	}
+
+	state := dfa.start.Load()
+	for _, fm := range state.fieldTransitions {
+		fieldSet[fm] = true
+	}
+	for index := 0; index <= len(val); index++ {
+		var utf8Byte byte
+		if index < len(val) {
+			utf8Byte = val[index]
+		} else {
+			utf8Byte = valueTerminator
+		}
+		next := state.next[utf8Byte].Load()
+		if next == nil {
+			next = dfa.step(state, utf8Byte, bufs.lazyDFAs)
+		}
+		if next == lazyDeadState {
+			break
+		}
+		for _, fm := range next.fieldTransitions {
+			fieldSet[fm] = true
+		}
+		state = next
+	}
+	return bufs.transitionsFromFieldSet(fieldSet)
+}
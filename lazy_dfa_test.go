@@ -0,0 +1,203 @@
+package quamina
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testTraverseLazyDFA is the lazy equivalent of testTraverseNFA
+func testTraverseLazyDFA(dfa *lazyDFA, val []byte, bufs *nfaBuffers) []*fieldMatcher {
+	tm := bufs.getTransmap()
+	tm.push()
+	result := traverseLazyDFA(dfa, val, nil, bufs)
+	tm.pop()
+	return result
+}
+
+func TestLazyDFAMatchesNFA(t *testing.T) {
+	patterns := []string{
+		`{"x": [ {"shellstyle": "*abc"} ]}`,
+		`{"x": [ {"shellstyle": "a*b*c*"} ]}`,
+		`{"x": [ {"wildcard": "*foo*bar*"} ]}`,
+		`{"x": [ {"regexp": "(a|ab)*c"} ]}`,
+		`{"x": [ {"regexp": "[a-c]+x?"} ]}`,
+		`{"x": [ "abc", "foobar", 35 ]}`,
+	}
+	events := []string{"abc", "xabc", "aabbcc", "foobar", "xfooybarz", "ababc", "abx", "ccc", "abd", "", "35"}
+
+	comfort := newCoreMatcher()
+	lazy := newCoreMatcher()
+	for i, pattern := range patterns {
+		if err := comfort.addPattern(i, pattern, BuiltForComfort); err != nil {
+			t.Fatal(err)
+		}
+		if err := lazy.addPattern(i, pattern, BuiltLazily); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// twice, so that the second time around the lazy DFA is running on cached states
+	for round := 0; round < 2; round++ {
+		for _, e := range events {
+			event := fmt.Sprintf(`{"x": "%s"}`, e)
+			if e == "35" {
+				event = `{"x": 35}`
+			}
+			want, _ := comfort.matchesForJSONEvent([]byte(event))
+			got, _ := lazy.matchesForJSONEvent([]byte(event))
+			if !sameXs(want, got) {
+				t.Errorf("round %d, %s: comfort %v lazy %v", round, e, want, got)
+			}
+		}
+	}
+
+	vm := lazy.fields().state.fields().transitions["x"]
+	if vm.fields().lazyDFA == nil {
+		t.Error("no lazy DFA")
+	}
+	if vm.fields().lazyDFA.cachedStates() < 2 {
+		t.Error("lazy DFA states not cached")
+	}
+}
+
+func sameXs(a, b []X) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[X]bool)
+	for _, x := range a {
+		seen[x] = true
+	}
+	for _, x := range b {
+		if !seen[x] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLazyDFABounded(t *testing.T) {
+	nfa, _ := makeShellStyleFA(asQuotedBytes(t, "*a*b*c*d*e*"), sharedNullPrinter)
+	epsilonClosure(nfa)
+	dfa := newLazyDFA(nfa)
+	bufs := newNfaBuffers()
+	bufs.lazyDFAs = newLazyDFABudget(4)
+
+	shoulds := []string{"abcde", "xaxbxcxdxex", "aabbccddee"}
+	nopes := []string{"abcd", "edcba", "abce"}
+	for round := 0; round < 3; round++ {
+		for _, should := range shoulds {
+			if len(testTraverseLazyDFA(dfa, asQuotedBytes(t, should), bufs)) != 1 {
+				t.Errorf("didn't match %s", should)
+			}
+			if dfa.cachedStates() > 4 {
+				t.Errorf("cache size %d", dfa.cachedStates())
+			}
+		}
+		for _, nope := range nopes {
+			if len(testTraverseLazyDFA(dfa, asQuotedBytes(t, nope), bufs)) != 0 {
+				t.Errorf("matched %s", nope)
+			}
+		}
+	}
+	if bufs.lazyDFAs.flushes.Load() == 0 {
+		t.Error("cache never flushed")
+	}
+}
+
+func TestLazyDFABudgetShared(t *testing.T) {
+	const fields = 100
+	const maxStates = 50
+	m := newCoreMatcher()
+	m.lazyDFAs = newLazyDFABudget(maxStates)
+	for i := 0; i < fields; i++ {
+		pattern := fmt.Sprintf(`{"f%03d": [ {"shellstyle": "*a*b*c*"} ]}`, i)
+		if err := m.addPattern(i, pattern, BuiltLazily); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cached := func() int {
+		total := 0
+		for _, vm := range m.fields().state.fields().transitions {
+			total += vm.fields().lazyDFA.cachedStates()
+		}
+		return total
+	}
+	for i := 0; i < fields; i++ {
+		event := fmt.Sprintf(`{"f%03d": "xaxbxcx"}`, i)
+		matches, _ := m.matchesForJSONEvent([]byte(event))
+		if len(matches) != 1 || matches[0] != i {
+			t.Errorf("%s: matched %v", event, matches)
+		}
+		// each lazyDFA keeps its start state
+		if total := cached(); total > maxStates+fields {
+			t.Errorf("%d states cached", total)
+		}
+	}
+	if m.lazyDFAs.flushes.Load() == 0 {
+		t.Error("cache never flushed")
+	}
+
+	// a lazyDFA whose automaton has been replaced is dropped at the next flush
+	replaced := m.fields().state.fields().transitions["f000"].fields().lazyDFA
+	if err := m.addPattern("new", `{"f000": [ {"shellstyle": "*z*"} ]}`, BuiltLazily); err != nil {
+		t.Fatal(err)
+	}
+	flushes := m.lazyDFAs.flushes.Load()
+	for i := 0; i < fields; i++ {
+		_, _ = m.matchesForJSONEvent([]byte(fmt.Sprintf(`{"f%03d": "xaxbxcx"}`, i)))
+	}
+	if m.lazyDFAs.flushes.Load() == flushes {
+		t.Fatal("cache not flushed again")
+	}
+	m.lazyDFAs.lock.Lock()
+	defer m.lazyDFAs.lock.Unlock()
+	if len(m.lazyDFAs.caches) > maxStates {
+		t.Errorf("%d lazyDFAs listed", len(m.lazyDFAs.caches))
+	}
+	for _, cache := range m.lazyDFAs.caches {
+		if cache == lazyCache(replaced) {
+			t.Error("replaced lazyDFA still listed")
+		}
+	}
+}
+
+func TestLazyDFAConcurrency(t *testing.T) {
+	q, _ := New()
+	_ = q.SetMatcherBuildMode(BuiltLazily)
+	// small enough that the goroutines flush each other's caches
+	q.matcher.(*coreMatcher).lazyDFAs = newLazyDFABudget(16)
+	for i := 0; i < 20; i++ {
+		if err := q.AddPattern(i, fmt.Sprintf(`{"x": [ {"shellstyle": "*%d*"} ]}`, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(q *Quamina) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				event := fmt.Sprintf(`{"x": "a%db"}`, i)
+				matches, err := q.MatchesForEvent([]byte(event))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				// every pattern whose number is a substring of i
+				want := 0
+				for p := 0; p < 20; p++ {
+					if strings.Contains(fmt.Sprint(i), fmt.Sprint(p)) {
+						want++
+					}
+				}
+				if len(matches) != want {
+					t.Errorf("%s: want %d got %d", event, want, len(matches))
+				}
+			}
+		}(q.Copy())
+	}
+	wg.Wait()
+}
@@ -0,0 +1,289 @@
+package quamina
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"unsafe"
+)
+
+// literalDAWG is a compact representation of a large set of exact-match string values, built by Compact.
+// The chains of smallTables that makeStringFA builds share prefixes when merged, but not suffixes, so with
+// many values, memory use is dominated by duplicated tails like `.example.com"`. The tails can't simply be
+// merged, because each value leads to its own fieldMatcher. So the values are stored in a minimal acyclic
+// automaton, sometimes called a DAWG (Directed Acyclic Word Graph), which shares both prefixes and suffixes,
+// and each node records how many values can be reached from it. As a lookup proceeds, this allows it to
+// compute the value's index among all the values (what's called "perfect hashing"), which is then used
+// to find the value's fieldMatchers in the transitions slice.
+// Values are indexed in the order in which a depth-first walk over the DAWG, taking the outgoing edges
+// in byte order, reaches them, with a value ending at a node coming after all the values that extend it.
+// That matches the order of makeStringFA's automata, whose valueTerminator byte is larger than any byte
+// that can appear in a UTF-8 string.
+// A literalDAWG is never changed once built; string values added afterward go into the valueMatcher's
+// automaton as usual, and are folded into a new literalDAWG the next time Compact runs.
+type literalDAWG struct {
+	root        int32
+	nodes       []dawgNode
+	edges       []dawgEdge
+	transitions [][]*fieldMatcher
+}
+
+// dawgNode's edges are at [firstEdge, firstEdge+edgeCount) in literalDAWG.edges, sorted by label. count is
+// the number of values that can be reached from the node, including the one ending there if it's final.
+type dawgNode struct {
+	firstEdge int32
+	count     int32
+	edgeCount uint16
+	final     bool
+}
+
+// dawgEdge's skip is the number of values reached through the edges that precede it at its node
+type dawgEdge struct {
+	label  byte
+	target int32
+	skip   int32
+}
+
+// lookup returns the fieldMatchers for val, or nil if it's not one of the DAWG's values
+func (d *literalDAWG) lookup(val []byte) []*fieldMatcher {
+	index, ok := d.index(val)
+	if !ok {
+		return nil
+	}
+	return d.transitions[index]
+}
+
+// index returns val's index among the DAWG's values, or false if it's not one of them
+func (d *literalDAWG) index(val []byte) (int32, bool) {
+	node := &d.nodes[d.root]
+	var index int32
+	for _, utf8Byte := range val {
+		edges := d.edges[node.firstEdge : node.firstEdge+int32(node.edgeCount)]
+		i, found := slices.BinarySearchFunc(edges, utf8Byte, func(e dawgEdge, b byte) int {
+			return int(e.label) - int(b)
+		})
+		if !found {
+			return 0, false
+		}
+		index += edges[i].skip
+		node = &d.nodes[edges[i].target]
+	}
+	if !node.final {
+		return 0, false
+	}
+	// the value ending here comes after all those that extend it
+	if node.edgeCount > 0 {
+		last := d.edges[node.firstEdge+int32(node.edgeCount)-1]
+		index += last.skip + d.nodes[last.target].count
+	}
+	return index, true
+}
+
+// literalValue is a value in a literalDAWG, and the fieldMatchers it leads to
+type literalValue struct {
+	val         []byte
+	transitions []*fieldMatcher
+}
+
+// values returns the DAWG's values in index order
+func (d *literalDAWG) values() []literalValue {
+	var values []literalValue
+	var walk func(node int32, path []byte)
+	walk = func(node int32, path []byte) {
+		n := d.nodes[node]
+		for _, edge := range d.edges[n.firstEdge : n.firstEdge+int32(n.edgeCount)] {
+			walk(edge.target, append(path, edge.label))
+		}
+		if n.final {
+			values = append(values, literalValue{val: slices.Clone(path), transitions: d.transitions[len(values)]})
+		}
+	}
+	walk(d.root, nil)
+	return values
+}
+
+// compareLiteralValues orders values as literalDAWG indexes them, with a value coming after those that
+// extend it
+func compareLiteralValues(a, b literalValue) int {
+	common := min(len(a.val), len(b.val))
+	if c := bytes.Compare(a.val[:common], b.val[:common]); c != 0 {
+		return c
+	}
+	return len(b.val) - len(a.val)
+}
+
+// newLiteralDAWG builds the minimal DAWG for values, which must be in the order compareLiteralValues
+// defines and contain no duplicates. It uses the algorithm for sorted input from Daciuk et al,
+// "Incremental Construction of Minimal Acyclic Finite-State Automata": it builds the trie path for each
+// value, and once a value has been added that doesn't share a node, the node can't change any more, so it
+// can be replaced by an equivalent node seen previously, if there is one.
+func newLiteralDAWG(values []literalValue) *literalDAWG {
+	b := dawgBuilder{
+		dawg:     &literalDAWG{transitions: make([][]*fieldMatcher, 0, len(values))},
+		register: make(map[string]int32),
+		path:     []*dawgPathNode{{}},
+	}
+	var previous []byte
+	for _, value := range values {
+		common := 0
+		for common < len(previous) && common < len(value.val) && previous[common] == value.val[common] {
+			common++
+		}
+		b.freezeDownTo(common)
+		for _, utf8Byte := range value.val[common:] {
+			parent := b.path[len(b.path)-1]
+			parent.labels = append(parent.labels, utf8Byte)
+			b.path = append(b.path, &dawgPathNode{})
+		}
+		b.path[len(b.path)-1].final = true
+		b.dawg.transitions = append(b.dawg.transitions, value.transitions)
+		previous = value.val
+	}
+	b.freezeDownTo(0)
+	b.dawg.root = b.freeze(b.path[0])
+	return b.dawg
+}
+
+// dawgPathNode is a node on the path of the most recently added value which may still change. Its edges'
+// targets, except for the last, which is the next node on the path, have been frozen.
+type dawgPathNode struct {
+	final   bool
+	labels  []byte
+	targets []int32
+}
+
+type dawgBuilder struct {
+	dawg     *literalDAWG
+	register map[string]int32
+	path     []*dawgPathNode
+	keyBuf   []byte
+}
+
+// freezeDownTo freezes the nodes on the path deeper than depth
+func (b *dawgBuilder) freezeDownTo(depth int) {
+	for len(b.path)-1 > depth {
+		last := len(b.path) - 1
+		frozen := b.freeze(b.path[last])
+		b.path = b.path[:last]
+		parent := b.path[last-1]
+		parent.targets = append(parent.targets, frozen)
+	}
+}
+
+// freeze returns the index of the node in the DAWG equivalent to the path node, adding it if necessary
+func (b *dawgBuilder) freeze(pn *dawgPathNode) int32 {
+	key := b.keyBuf[:0]
+	if pn.final {
+		key = append(key, 1)
+	} else {
+		key = append(key, 0)
+	}
+	for i, label := range pn.labels {
+		key = append(key, label)
+		key = binary.LittleEndian.AppendUint32(key, uint32(pn.targets[i]))
+	}
+	b.keyBuf = key
+	if node, ok := b.register[string(key)]; ok {
+		return node
+	}
+
+	d := b.dawg
+	node := dawgNode{firstEdge: int32(len(d.edges)), edgeCount: uint16(len(pn.labels)), final: pn.final}
+	for i, label := range pn.labels {
+		d.edges = append(d.edges, dawgEdge{label: label, target: pn.targets[i], skip: node.count})
+		node.count += d.nodes[pn.targets[i]].count
+	}
+	if pn.final {
+		node.count++
+	}
+	index := int32(len(d.nodes))
+	d.nodes = append(d.nodes, node)
+	b.register[string(key)] = index
+	return index
+}
+
+// literalValues returns the values matched by an automaton built only by makeStringFA, in the order
+// compareLiteralValues defines, or false if the automaton has any other structure.
+func literalValues(start *faState) ([]literalValue, bool) {
+	var values []literalValue
+	var walk func(state *faState, path []byte) bool
+	walk = func(state *faState, path []byte) bool {
+		if len(state.table.epsilons) != 0 {
+			return false
+		}
+		if len(state.fieldTransitions) != 0 {
+			// a match only happens after the valueTerminator
+			if len(path) == 0 || path[len(path)-1] != valueTerminator {
+				return false
+			}
+			values = append(values, literalValue{
+				val:         slices.Clone(path[:len(path)-1]),
+				transitions: dedupFieldTransitions(state.fieldTransitions),
+			})
+		}
+		var floor byte
+		for i, step := range state.table.steps {
+			ceiling := state.table.ceilings[i]
+			if step != nil {
+				if ceiling-floor != 1 || !walk(step, append(path, floor)) {
+					return false
+				}
+			}
+			floor = ceiling
+		}
+		return true
+	}
+	if !walk(start, nil) {
+		return nil, false
+	}
+	return values, true
+}
+
+// compressLiterals moves the string values in a valueMatcher's automaton into a literalDAWG. It only does
+// so if there have only ever been string values in the automaton, and it has more than one value; the
+// singleton case needs no help.
+func (m *valueMatcher) compressLiterals() {
+	current := m.fields()
+	if current.hasNonLiterals || current.start == nil {
+		return
+	}
+	values, ok := literalValues(current.start)
+	if !ok {
+		return
+	}
+	if current.literals != nil {
+		values = append(values, current.literals.values()...)
+	}
+	slices.SortFunc(values, compareLiteralValues)
+
+	// a value may already have been in the literalDAWG when it was added again
+	merged := values[:0]
+	for _, value := range values {
+		last := len(merged) - 1
+		if last >= 0 && bytes.Equal(merged[last].val, value.val) {
+			merged[last].transitions = dedupFieldTransitions(append(merged[last].transitions, value.transitions...))
+			continue
+		}
+		merged = append(merged, value)
+	}
+
+	fields := m.getFieldsForUpdate()
+	fields.literals = newLiteralDAWG(merged)
+	fields.start = nil
+	fields.isNondeterministic = false
+	fields.lazyDFA = nil
+	fields.dfaStates = nil
+	m.update(fields)
+}
+
+// mcLiteralDAWG is the memory cost of a literalDAWG
+func mcLiteralDAWG(d *literalDAWG) int64 {
+	cost := int64(unsafe.Sizeof(*d))
+	cost += int64(cap(d.nodes)) * int64(unsafe.Sizeof(dawgNode{}))
+	cost += int64(cap(d.edges)) * int64(unsafe.Sizeof(dawgEdge{}))
+	cost += int64(cap(d.transitions)) * int64(unsafe.Sizeof([]*fieldMatcher{}))
+	for _, transitions := range d.transitions {
+		cost += mcPointer * int64(cap(transitions))
+	}
+	return cost
+}
@@ -0,0 +1,155 @@
+package quamina
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestLiteralDAWG(t *testing.T) {
+	words := []string{`"a"`, `"ab"`, `"abc"`, `"b"`, `"xab"`, `"xabc"`, `"yabc"`, `"zz"`, `true`, `truer`}
+	var values []literalValue
+	for _, word := range words {
+		values = append(values, literalValue{val: []byte(word), transitions: []*fieldMatcher{newFieldMatcher()}})
+	}
+	slices.SortFunc(values, compareLiteralValues)
+	dawg := newLiteralDAWG(values)
+
+	for _, value := range values {
+		got := dawg.lookup(value.val)
+		if len(got) != 1 || got[0] != value.transitions[0] {
+			t.Errorf("lookup %s: wrong transitions", value.val)
+		}
+	}
+	for _, nope := range []string{``, `"`, `"ab`, `"abcd"`, `"c"`, `"xa"`, `tru`, `truex`} {
+		if dawg.lookup([]byte(nope)) != nil {
+			t.Errorf("lookup %s succeeded", nope)
+		}
+	}
+
+	// the suffixes are shared; a trie would need one node per distinct prefix
+	prefixes := make(map[string]bool)
+	for _, word := range words {
+		for i := 0; i <= len(word); i++ {
+			prefixes[word[:i]] = true
+		}
+	}
+	if len(dawg.nodes) >= len(prefixes) {
+		t.Errorf("%d DAWG nodes, %d trie nodes", len(dawg.nodes), len(prefixes))
+	}
+
+	// round trip
+	again := dawg.values()
+	if len(again) != len(values) {
+		t.Fatalf("values() returned %d, wanted %d", len(again), len(values))
+	}
+	for i, value := range again {
+		if string(value.val) != string(values[i].val) || value.transitions[0] != values[i].transitions[0] {
+			t.Errorf("values()[%d] is %s", i, value.val)
+		}
+	}
+}
+
+func TestLiteralValues(t *testing.T) {
+	cm := newCoreMatcher()
+	for _, v := range []string{`"ab"`, `"abc"`, `"b"`, `true`} {
+		if err := cm.addPattern(v, `{"x": [`+v+`]}`, BuiltForComfort); err != nil {
+			t.Fatal(err)
+		}
+	}
+	vm := cm.fields().state.fields().transitions["x"]
+	values, ok := literalValues(vm.fields().start)
+	if !ok {
+		t.Fatal("not literal")
+	}
+	var got []string
+	for _, value := range values {
+		got = append(got, string(value.val))
+	}
+	want := []string{`"ab"`, `"abc"`, `"b"`, `true`}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	if err := cm.addPattern("s", `{"x": [ {"shellstyle": "a*"} ]}`, BuiltForComfort); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := literalValues(vm.fields().start); ok {
+		t.Error("shellstyle automaton considered literal")
+	}
+}
+
+func TestCompactLiterals(t *testing.T) {
+	q, _ := New()
+	for i := 0; i < 1000; i++ {
+		pattern := fmt.Sprintf(`{"host": ["h%d.example.com"]}`, i)
+		if err := q.AddPattern(i, pattern); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.AddPattern("both", `{"host": ["h7.example.com"], "port": [443]}`); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.AddPattern("other", `{"port": ["x", "y", {"prefix": "z"}]}`); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(when string, extra ...X) {
+		t.Helper()
+		for _, i := range []int{0, 7, 42, 999} {
+			event := fmt.Sprintf(`{"host": "h%d.example.com", "port": 443}`, i)
+			matches, err := q.MatchesForEvent([]byte(event))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := []X{i}
+			if i == 7 {
+				want = append(want, "both")
+			}
+			if i == 42 {
+				want = append(want, extra...)
+			}
+			if !sameXs(want, matches) {
+				t.Errorf("%s: %s matched %v, wanted %v", when, event, matches, want)
+			}
+		}
+		for _, event := range []string{`{"host": "h1001.example.com"}`, `{"host": "h1.example.co"}`, `{"host": 3}`} {
+			matches, _ := q.MatchesForEvent([]byte(event))
+			if len(matches) != 0 {
+				t.Errorf("%s: %s matched %v", when, event, matches)
+			}
+		}
+		matches, _ := q.MatchesForEvent([]byte(`{"port": "zz"}`))
+		if !sameXs(matches, []X{"other"}) {
+			t.Errorf("%s: port zz matched %v", when, matches)
+		}
+	}
+
+	check("before")
+	before := q.GetMatcherStats()["bytes"]
+	q.Compact()
+	after := q.GetMatcherStats()["bytes"]
+	check("after")
+	if after*4 > before {
+		t.Errorf("compaction only reduced %.0f bytes to %.0f", before, after)
+	}
+
+	// add to the compressed values, both a new value and one that's already there
+	if err := q.AddPattern("new", `{"host": ["h1000.example.com"]}`); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.AddPattern("again", `{"host": ["h42.example.com"]}`); err != nil {
+		t.Fatal(err)
+	}
+	check("added", "again")
+	matches, _ := q.MatchesForEvent([]byte(`{"host": "h1000.example.com"}`))
+	if !sameXs(matches, []X{"new"}) {
+		t.Errorf("h1000 matched %v", matches)
+	}
+	q.Compact()
+	check("compacted again", "again")
+	matches, _ = q.MatchesForEvent([]byte(`{"host": "h1000.example.com"}`))
+	if !sameXs(matches, []X{"new"}) {
+		t.Errorf("h1000 matched %v after compaction", matches)
+	}
+}
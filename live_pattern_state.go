@@ -1,9 +1,5 @@
 package quamina
 
-import (
-	"sync"
-)
-
 // LivePatternsState represents the required capabilities for maintaining the
 // set of live patterns.
 type LivePatternsState interface {
@@ -22,65 +18,3 @@ type LivePatternsState interface {
 	// Contains returns true if x is in the live set; false otherwise.
 	Contains(x X) (bool, error)
 }
-
-// memState is a LivePatternsState that is just a slice of buildMode/pattern pairs
-//
-// Since the LivePatternsState implementation can be provided to the
-// application, we're keeping things simple here initially.
-type memStateEntry struct {
-	x           X
-	pattern     string
-	builderMode MatcherBuildMode
-}
-type memState struct {
-	lock    sync.RWMutex
-	entries []memStateEntry
-}
-
-func newMemState() *memState {
-	return &memState{}
-}
-func (s *memState) Add(x X, pattern string, buildMode MatcherBuildMode) error {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	s.entries = append(s.entries, memStateEntry{x, pattern, buildMode})
-	return nil
-}
-func (s *memState) Delete(x X) (int, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	howMany := 0
-	var newEntries []memStateEntry
-	for _, entry := range s.entries {
-		if entry.x == x {
-			howMany++
-		} else {
-			newEntries = append(newEntries, entry)
-		}
-	}
-	s.entries = newEntries
-	return howMany, nil
-}
-
-func (s *memState) Contains(x X) (bool, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	for _, entry := range s.entries {
-		if entry.x == x {
-			return true, nil
-		}
-	}
-	return false, nil
-}
-func (s *memState) Iterate(f func(x X, pattern string, buildMode MatcherBuildMode) error) error {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	var err error
-	for _, entry := range s.entries {
-		err = f(entry.x, entry.pattern, entry.builderMode)
-		if err != nil {
-			break
-		}
-	}
-	return err
-}
@@ -0,0 +1,720 @@
+package quamina
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// The serialized form of a matcher is its frozenMatcher (see frozen_matcher.go), which is already a set of
+// flat slices of integers, preceded by the settings which affect how patterns are compiled and the paths in
+// the segmentsTree. A restored matcher is a frozen one, so patterns added after restoring go into the overlay
+// as they would after Freeze.
+// Integers are written as varints, and each slice is preceded by its length. The X values are converted to
+// bytes by the caller's XCodec. The predicate functions of custom operators, value sets, and samples can't
+// be serialized, so they are looked up by name when the matcher is restored; custom operators and value sets
+// must have been registered by then.
+
+// marshalMagic begins the serialized form, followed by marshalVersion
+const (
+	marshalMagic   = "QMNA"
+	marshalVersion = 1
+)
+
+// XCodec converts the X values of Patterns to and from bytes, for use by MarshalBinary and UnmarshalBinary.
+// UnmarshalX must produce an X value equal to the one MarshalX was given.
+type XCodec interface {
+	MarshalX(x X) ([]byte, error)
+	UnmarshalX(data []byte) (X, error)
+}
+
+// settings flags
+const (
+	marshalFoldFieldNames = 1 << iota
+	marshalNullAsMissing
+	marshalNumericStrings
+	marshalExactIntegers
+	marshalHasExclusions
+)
+
+// valueMatcher flags
+const (
+	marshalHasNumbers = 1 << iota
+	marshalIsNondeterministic
+	marshalVMNumericStrings
+	marshalVMExactIntegers
+	marshalHasNonLiterals
+)
+
+// frozenForm returns a frozenMatcher for the whole automaton: the one produced by freeze if there's no
+// overlay and nothing has been deleted from it since, otherwise a new one. It must be called with the lock
+// held.
+func (m *coreMatcher) frozenForm(buildMode MatcherBuildMode) *frozenMatcher {
+	current := m.fields()
+	if current.frozen != nil && current.state.isEmpty() && len(current.deleted) == 0 {
+		return current.frozen
+	}
+	return freezeFieldMatcher(m.wholeAutomaton(buildMode))
+}
+
+// isEmpty returns true if no pattern has been added to the fieldMatcher
+func (m *fieldMatcher) isEmpty() bool {
+	fields := m.fields()
+	return len(fields.transitions) == 0 && len(fields.matches) == 0 && len(fields.existsTrue) == 0 &&
+		len(fields.existsFalse) == 0 && len(fields.existsFalseIgnoringNull) == 0 && len(fields.equalsField) == 0
+}
+
+func (m *coreMatcher) marshalBinary(codec XCodec, buildMode MatcherBuildMode) ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	current := m.fields()
+	e := &encoder{codec: codec}
+	e.buf = append(e.buf, marshalMagic...)
+	e.uvarint(marshalVersion)
+
+	flags := m.opts.marshalFlags() | flagIf(current.hasExclusions, marshalHasExclusions)
+	e.uvarint(flags)
+	e.uvarint(uint64(current.maxQuantifier))
+	e.uvarint(uint64(current.largeQuantifiers))
+
+	paths := current.segmentsTree.paths(nil)
+	e.uvarint(uint64(len(paths)))
+	for _, path := range paths {
+		e.bytes(path)
+	}
+
+	e.frozenMatcher(m.frozenForm(buildMode))
+	return e.buf, e.err
+}
+
+// unmarshalBinary replaces the automaton with the one in data, which must have been compiled with the same
+// settings as the coreMatcher's. As with freeze, buildMode says whether its NFAs are to be converted to DFAs
+// lazily during matching.
+func (m *coreMatcher) unmarshalBinary(data []byte, codec XCodec, buildMode MatcherBuildMode) error {
+	d := &decoder{data: data, codec: codec}
+	if len(data) < len(marshalMagic) || string(data[:len(marshalMagic)]) != marshalMagic {
+		return errors.New("not a serialized Quamina matcher")
+	}
+	d.data = d.data[len(marshalMagic):]
+	if version := d.uvarint(); d.err == nil && version != marshalVersion {
+		return fmt.Errorf("unsupported serialization version %d", version)
+	}
+
+	flags := d.uvarint()
+	if d.err == nil && flags&^marshalHasExclusions != m.opts.marshalFlags() {
+		return errors.New("serialized matcher was built with different options")
+	}
+	freshStart := &coreFields{
+		state:            newFieldMatcher(),
+		hasExclusions:    flags&marshalHasExclusions != 0,
+		maxQuantifier:    int(d.uvarint()),
+		largeQuantifiers: int(d.uvarint()),
+	}
+
+	freshStart.segmentsTree = newSegmentsIndex()
+	freshStart.segmentsTree.foldCase = m.opts.foldFieldNames
+	for n := d.count(); n > 0; n-- {
+		freshStart.segmentsTree.add(string(d.bytes()))
+	}
+
+	fz := d.frozenMatcher()
+	if d.err != nil {
+		return d.err
+	}
+	if len(d.data) != 0 {
+		return errors.New("serialized matcher has trailing data")
+	}
+	if err := fz.validate(); err != nil {
+		return err
+	}
+	if buildMode == BuiltLazily {
+		fz.prepareLazyDFAs()
+	}
+	freshStart.frozen = fz
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.deletion != nil {
+		m.deletion.reset(fz)
+	}
+	m.updateable.Store(freshStart)
+	return nil
+}
+
+// marshalFlags records the settings which affect how patterns are compiled
+func (opts *patternOptions) marshalFlags() uint64 {
+	return flagIf(opts.foldFieldNames, marshalFoldFieldNames) | flagIf(opts.nullAsMissing, marshalNullAsMissing) |
+		flagIf(opts.numericStrings, marshalNumericStrings) | flagIf(opts.exactIntegers, marshalExactIntegers)
+}
+
+func flagIf(set bool, flag uint64) uint64 {
+	if set {
+		return flag
+	}
+	return 0
+}
+
+// paths appends the Path values of all the fields in the tree to paths; adding them to an empty tree
+// reproduces this one
+func (p *segmentsTree) paths(paths [][]byte) [][]byte {
+	for _, path := range p.fields {
+		paths = append(paths, path)
+	}
+	for _, node := range p.nodes {
+		paths = node.paths(paths)
+	}
+	return paths
+}
+
+//
+// Encoding
+//
+
+type encoder struct {
+	buf   []byte
+	codec XCodec
+	err   error
+}
+
+func (e *encoder) uvarint(v uint64) {
+	e.buf = binary.AppendUvarint(e.buf, v)
+}
+
+func (e *encoder) varint(v int32) {
+	e.buf = binary.AppendVarint(e.buf, int64(v))
+}
+
+func (e *encoder) span(s span) {
+	e.varint(s.off)
+	e.varint(s.len)
+}
+
+func (e *encoder) bytes(b []byte) {
+	e.uvarint(uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *encoder) int32s(vals []int32) {
+	e.uvarint(uint64(len(vals)))
+	for _, v := range vals {
+		e.varint(v)
+	}
+}
+
+func (e *encoder) x(x X) {
+	if e.err != nil {
+		return
+	}
+	excluded, isExclusion := x.(exclusionX)
+	if isExclusion {
+		x = excluded.x
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+	data, err := e.codec.MarshalX(x)
+	if err != nil {
+		e.err = fmt.Errorf("can't marshal %v: %w", x, err)
+		return
+	}
+	e.bytes(data)
+}
+
+func (e *encoder) frozenMatcher(fz *frozenMatcher) {
+	e.varint(fz.root)
+
+	e.uvarint(uint64(len(fz.fms)))
+	for _, fm := range fz.fms {
+		e.span(fm.transitions)
+		e.span(fm.matches)
+		e.span(fm.existsTrue)
+		e.span(fm.existsFalse)
+		e.span(fm.existsFalseIgnoringNull)
+		e.span(fm.equalsField)
+	}
+
+	e.uvarint(uint64(len(fz.vms)))
+	for _, vm := range fz.vms {
+		e.varint(vm.start)
+		e.span(vm.singletonMatch)
+		e.varint(vm.singletonTransition)
+		e.span(vm.predicates)
+		e.varint(vm.literals)
+		e.uvarint(flagIf(vm.hasNumbers, marshalHasNumbers) | flagIf(vm.isNondeterministic, marshalIsNondeterministic) |
+			flagIf(vm.numericStrings, marshalVMNumericStrings) | flagIf(vm.exactIntegers, marshalVMExactIntegers) |
+			flagIf(vm.hasNonLiterals, marshalHasNonLiterals))
+	}
+
+	e.uvarint(uint64(len(fz.states)))
+	for _, state := range fz.states {
+		e.span(state.table)
+		e.span(state.epsilons)
+		e.span(state.closure)
+		e.span(state.transitions)
+		e.uvarint(flagIf(state.isSpinner, 1))
+	}
+
+	e.bytes(fz.ceilings)
+	e.int32s(fz.steps)
+	e.int32s(fz.stateRefs)
+	e.int32s(fz.fmRefs)
+
+	e.uvarint(uint64(len(fz.pathEdges)))
+	for _, edge := range fz.pathEdges {
+		e.span(edge.path)
+		e.varint(edge.target)
+	}
+	e.uvarint(uint64(len(fz.equalsEdges)))
+	for _, edge := range fz.equalsEdges {
+		e.span(edge.path)
+		e.span(edge.refPath)
+		e.varint(edge.target)
+	}
+	e.bytes(fz.arena)
+
+	e.uvarint(uint64(len(fz.xs)))
+	for _, x := range fz.xs {
+		e.x(x)
+	}
+
+	e.uvarint(uint64(len(fz.predicates)))
+	for _, p := range fz.predicates {
+		e.uvarint(uint64(p.vType))
+		e.bytes([]byte(p.name))
+		e.varint(p.next)
+	}
+
+	e.uvarint(uint64(len(fz.literals)))
+	for _, fl := range fz.literals {
+		e.varint(fl.root)
+		e.uvarint(uint64(len(fl.nodes)))
+		for _, node := range fl.nodes {
+			e.varint(node.firstEdge)
+			e.varint(node.count)
+			e.uvarint(uint64(node.edgeCount))
+			e.uvarint(flagIf(node.final, 1))
+		}
+		e.uvarint(uint64(len(fl.edges)))
+		for _, edge := range fl.edges {
+			e.uvarint(uint64(edge.label))
+			e.varint(edge.target)
+			e.varint(edge.skip)
+		}
+		e.uvarint(uint64(len(fl.transitions)))
+		for _, s := range fl.transitions {
+			e.span(s)
+		}
+	}
+}
+
+//
+// Decoding
+//
+
+// decoder reads what encoder writes. After the first error, it returns zero values and the error is in err.
+type decoder struct {
+	data  []byte
+	codec XCodec
+	err   error
+}
+
+var errTruncated = errors.New("serialized matcher is truncated or corrupt")
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) varint() int32 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 || v != int64(int32(v)) {
+		d.err = errTruncated
+		return 0
+	}
+	d.data = d.data[n:]
+	return int32(v)
+}
+
+func (d *decoder) span() span {
+	return span{off: d.varint(), len: d.varint()}
+}
+
+// count reads the length of a slice. Every element takes at least one byte, so a length greater than the
+// remaining data must be corrupt, and rejecting it avoids huge allocations.
+func (d *decoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.err = errTruncated
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.data)) {
+		d.err = errTruncated
+		return nil
+	}
+	// copied, because the caller may reuse data
+	b := slices.Clone(d.data[:n])
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) int32s() []int32 {
+	vals := make([]int32, d.count())
+	for i := range vals {
+		vals[i] = d.varint()
+	}
+	return vals
+}
+
+func (d *decoder) x() X {
+	isExclusion := d.uvarint()
+	data := d.bytes()
+	if d.err != nil {
+		return nil
+	}
+	x, err := d.codec.UnmarshalX(data)
+	if err != nil {
+		d.err = fmt.Errorf("can't unmarshal X: %w", err)
+		return nil
+	}
+	if isExclusion != 0 {
+		return exclusionX{x}
+	}
+	return x
+}
+
+func (d *decoder) frozenMatcher() *frozenMatcher {
+	fz := &frozenMatcher{root: d.varint()}
+
+	fz.fms = make([]frozenFieldMatcher, d.count())
+	for i := range fz.fms {
+		fz.fms[i] = frozenFieldMatcher{
+			transitions:             d.span(),
+			matches:                 d.span(),
+			existsTrue:              d.span(),
+			existsFalse:             d.span(),
+			existsFalseIgnoringNull: d.span(),
+			equalsField:             d.span(),
+		}
+	}
+
+	fz.vms = make([]frozenValueMatcher, d.count())
+	for i := range fz.vms {
+		vm := frozenValueMatcher{
+			start:               d.varint(),
+			singletonMatch:      d.span(),
+			singletonTransition: d.varint(),
+			predicates:          d.span(),
+			literals:            d.varint(),
+			lazyDFA:             -1,
+		}
+		flags := d.uvarint()
+		vm.hasNumbers = flags&marshalHasNumbers != 0
+		vm.isNondeterministic = flags&marshalIsNondeterministic != 0
+		vm.numericStrings = flags&marshalVMNumericStrings != 0
+		vm.exactIntegers = flags&marshalVMExactIntegers != 0
+		vm.hasNonLiterals = flags&marshalHasNonLiterals != 0
+		fz.vms[i] = vm
+	}
+
+	fz.states = make([]frozenState, d.count())
+	for i := range fz.states {
+		fz.states[i] = frozenState{
+			table:       d.span(),
+			epsilons:    d.span(),
+			closure:     d.span(),
+			transitions: d.span(),
+			isSpinner:   d.uvarint() != 0,
+		}
+	}
+
+	fz.ceilings = d.bytes()
+	fz.steps = d.int32s()
+	fz.stateRefs = d.int32s()
+	fz.fmRefs = d.int32s()
+
+	fz.pathEdges = make([]frozenPathEdge, d.count())
+	for i := range fz.pathEdges {
+		fz.pathEdges[i] = frozenPathEdge{path: d.span(), target: d.varint()}
+	}
+	fz.equalsEdges = make([]frozenEqualsEdge, d.count())
+	for i := range fz.equalsEdges {
+		fz.equalsEdges[i] = frozenEqualsEdge{path: d.span(), refPath: d.span(), target: d.varint()}
+	}
+	fz.arena = d.bytes()
+
+	fz.xs = make([]X, d.count())
+	for i := range fz.xs {
+		fz.xs[i] = d.x()
+	}
+
+	fz.predicates = make([]frozenPredicate, d.count())
+	for i := range fz.predicates {
+		p := frozenPredicate{vType: valType(d.uvarint()), name: string(d.bytes()), next: d.varint()}
+		if d.err != nil {
+			return nil
+		}
+		predicate, ok := predicateFor(p.vType, p.name)
+		if !ok {
+			d.err = fmt.Errorf("serialized matcher uses %q, which has not been registered", p.name)
+			return nil
+		}
+		p.predicate = predicate
+		fz.predicates[i] = p
+	}
+
+	fz.literals = make([]frozenLiterals, d.count())
+	for i := range fz.literals {
+		fl := frozenLiterals{root: d.varint()}
+		fl.nodes = make([]dawgNode, d.count())
+		for j := range fl.nodes {
+			fl.nodes[j] = dawgNode{firstEdge: d.varint(), count: d.varint(), edgeCount: uint16(d.uvarint()), final: d.uvarint() != 0}
+		}
+		fl.edges = make([]dawgEdge, d.count())
+		for j := range fl.edges {
+			fl.edges[j] = dawgEdge{label: byte(d.uvarint()), target: d.varint(), skip: d.varint()}
+		}
+		fl.transitions = make([]span, d.count())
+		for j := range fl.transitions {
+			fl.transitions[j] = d.span()
+		}
+		fz.literals[i] = fl
+	}
+	if d.err != nil {
+		return nil
+	}
+	return fz
+}
+
+//
+// Validation
+//
+
+var errInconsistent = errors.New("serialized matcher is inconsistent")
+
+// validate checks that all the indexes and spans in a frozenMatcher are in range, so that matching can't
+// go out of bounds, and that the literal DAWGs' counts are consistent, so that lookups can't either.
+func (fz *frozenMatcher) validate() error {
+	fmOK := func(i int32) bool { return i >= 0 && int(i) < len(fz.fms) }
+	stateOK := func(i int32) bool { return i >= -1 && int(i) < len(fz.states) }
+	in := func(s span, n int) bool { return s.off >= 0 && s.len >= 0 && int64(s.off)+int64(s.len) <= int64(n) }
+	inOrNil := func(s span, n int) bool { return s == nilSpan || in(s, n) }
+	edgesOK := func(s span, targetOK func(int32) bool) bool {
+		if !in(s, len(fz.pathEdges)) {
+			return false
+		}
+		for _, edge := range fz.edgesIn(s) {
+			if !in(edge.path, len(fz.arena)) || !targetOK(edge.target) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if !fmOK(fz.root) || len(fz.ceilings) != len(fz.steps) {
+		return errInconsistent
+	}
+	for _, fm := range fz.fms {
+		if !edgesOK(fm.transitions, func(i int32) bool { return i >= 0 && int(i) < len(fz.vms) }) ||
+			!in(fm.matches, len(fz.xs)) || !edgesOK(fm.existsTrue, fmOK) || !edgesOK(fm.existsFalse, fmOK) ||
+			!edgesOK(fm.existsFalseIgnoringNull, fmOK) || !in(fm.equalsField, len(fz.equalsEdges)) {
+			return errInconsistent
+		}
+	}
+	for _, edge := range fz.equalsEdges {
+		if !in(edge.path, len(fz.arena)) || !in(edge.refPath, len(fz.arena)) || !fmOK(edge.target) {
+			return errInconsistent
+		}
+	}
+	for _, vm := range fz.vms {
+		if !stateOK(vm.start) || !inOrNil(vm.singletonMatch, len(fz.arena)) || !in(vm.predicates, len(fz.predicates)) ||
+			vm.literals < -1 || int(vm.literals) >= len(fz.literals) {
+			return errInconsistent
+		}
+		if vm.singletonMatch != nilSpan && !fmOK(vm.singletonTransition) {
+			return errInconsistent
+		}
+	}
+	for _, state := range fz.states {
+		if !in(state.table, len(fz.steps)) || !inOrNil(state.epsilons, len(fz.stateRefs)) ||
+			!inOrNil(state.closure, len(fz.stateRefs)) || !in(state.transitions, len(fz.fmRefs)) {
+			return errInconsistent
+		}
+	}
+	for _, step := range fz.steps {
+		if !stateOK(step) {
+			return errInconsistent
+		}
+	}
+	for _, ref := range fz.stateRefs {
+		if ref < 0 || !stateOK(ref) {
+			return errInconsistent
+		}
+	}
+	for _, ref := range fz.fmRefs {
+		if !fmOK(ref) {
+			return errInconsistent
+		}
+	}
+	for _, p := range fz.predicates {
+		if !fmOK(p.next) {
+			return errInconsistent
+		}
+	}
+	for _, fl := range fz.literals {
+		if err := fl.validate(len(fz.fmRefs)); err != nil {
+			return err
+		}
+	}
+	if !fz.fieldMatchersAcyclic() {
+		return errInconsistent
+	}
+	return nil
+}
+
+// fieldMatchersAcyclic checks that no fieldMatcher can lead back to itself, which would make tryToMatch
+// recurse forever; the automata leading from one fieldMatcher to the next may have loops, of course
+func (fz *frozenMatcher) fieldMatchersAcyclic() bool {
+	// the fieldMatchers each valueMatcher leads to
+	vmNext := make([][]int32, len(fz.vms))
+	// states may be shared between automata, so seenState records which valueMatcher's search saw each
+	seenState := make([]int, len(fz.states))
+	for i, vm := range fz.vms {
+		var next []int32
+		if vm.singletonMatch != nilSpan {
+			next = append(next, vm.singletonTransition)
+		}
+		for _, p := range fz.predicates[vm.predicates.off : vm.predicates.off+vm.predicates.len] {
+			next = append(next, p.next)
+		}
+		if vm.literals >= 0 {
+			for _, s := range fz.literals[vm.literals].transitions {
+				next = append(next, fz.fmRefs[s.off:s.off+s.len]...)
+			}
+		}
+		var states []int32
+		visit := func(state int32) {
+			if state >= 0 && seenState[state] != i+1 {
+				seenState[state] = i + 1
+				states = append(states, state)
+			}
+		}
+		visit(vm.start)
+		for j := 0; j < len(states); j++ {
+			state := fz.states[states[j]]
+			next = append(next, fz.fieldTransitions(states[j])...)
+			for _, step := range fz.steps[state.table.off : state.table.off+state.table.len] {
+				visit(step)
+			}
+			if state.epsilons.len > 0 {
+				for _, epsilon := range fz.stateRefs[state.epsilons.off : state.epsilons.off+state.epsilons.len] {
+					visit(epsilon)
+				}
+			}
+		}
+		vmNext[i] = next
+	}
+
+	// depth-first search, looking for an edge back to a fieldMatcher on the stack
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	color := make([]byte, len(fz.fms))
+	var acyclic func(fm int32) bool
+	acyclic = func(fm int32) bool {
+		switch color[fm] {
+		case onStack:
+			return false
+		case done:
+			return true
+		}
+		color[fm] = onStack
+		frozen := fz.fms[fm]
+		for _, s := range []span{frozen.existsTrue, frozen.existsFalse, frozen.existsFalseIgnoringNull} {
+			for _, edge := range fz.edgesIn(s) {
+				if !acyclic(edge.target) {
+					return false
+				}
+			}
+		}
+		for _, edge := range fz.equalsEdges[frozen.equalsField.off : frozen.equalsField.off+frozen.equalsField.len] {
+			if !acyclic(edge.target) {
+				return false
+			}
+		}
+		for _, edge := range fz.edgesIn(frozen.transitions) {
+			for _, next := range vmNext[edge.target] {
+				if !acyclic(next) {
+					return false
+				}
+			}
+		}
+		color[fm] = done
+		return true
+	}
+	for fm := range fz.fms {
+		if !acyclic(int32(fm)) {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks that a DAWG is acyclic, which newLiteralDAWG ensures by numbering nodes after those they
+// lead to, and that its counts and skips agree with its structure
+func (fl *frozenLiterals) validate(fmRefs int) error {
+	if fl.root < 0 || int(fl.root) >= len(fl.nodes) {
+		return errInconsistent
+	}
+	for i, node := range fl.nodes {
+		if node.firstEdge < 0 || int(node.firstEdge)+int(node.edgeCount) > len(fl.edges) {
+			return errInconsistent
+		}
+		var count int32
+		for _, edge := range fl.edges[node.firstEdge : node.firstEdge+int32(node.edgeCount)] {
+			if edge.target < 0 || int(edge.target) >= i || edge.skip != count {
+				return errInconsistent
+			}
+			count += fl.nodes[edge.target].count
+			if int(count) > len(fl.transitions) {
+				return errInconsistent
+			}
+		}
+		if node.final {
+			count++
+		}
+		if node.count != count {
+			return errInconsistent
+		}
+	}
+	if int(fl.nodes[fl.root].count) != len(fl.transitions) {
+		return errInconsistent
+	}
+	for _, s := range fl.transitions {
+		if s.off < 0 || s.len < 0 || int(s.off)+int(s.len) > fmRefs {
+			return errInconsistent
+		}
+	}
+	return nil
+}
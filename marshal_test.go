@@ -0,0 +1,199 @@
+package quamina
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// testXCodec handles int and string X values
+type testXCodec struct{}
+
+func (testXCodec) MarshalX(x X) ([]byte, error) {
+	switch x := x.(type) {
+	case int:
+		return []byte("i" + strconv.Itoa(x)), nil
+	case string:
+		return []byte("s" + x), nil
+	}
+	return nil, fmt.Errorf("unsupported X type %T", x)
+}
+
+func (testXCodec) UnmarshalX(data []byte) (X, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty X")
+	}
+	switch data[0] {
+	case 'i':
+		return strconv.Atoi(string(data[1:]))
+	case 's':
+		return string(data[1:]), nil
+	}
+	return nil, errors.New("bad X")
+}
+
+func TestMarshalBinary(t *testing.T) {
+	patterns := frozenTestPatterns()
+	events := frozenTestEvents()
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		original, _ := New(WithXCodec(testXCodec{}))
+		_ = original.SetMatcherBuildMode(mode)
+		for i, pattern := range patterns {
+			if err := original.AddPattern(i, pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		if err := original.AddExclusionPattern(0, `{"a": ["y"]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		check := func(when string, restored *Quamina) {
+			t.Helper()
+			for _, event := range events {
+				want, _ := original.MatchesForEvent([]byte(event))
+				got, err := restored.MatchesForEvent([]byte(event))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				if !sameXs(want, got) {
+					t.Errorf("mode %d, %s, %s: wanted %v got %v", mode, when, event, want, got)
+				}
+			}
+		}
+		roundTrip := func(when string) *Quamina {
+			t.Helper()
+			data, err := original.MarshalBinary()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			restored, _ := New(WithXCodec(testXCodec{}))
+			_ = restored.SetMatcherBuildMode(mode)
+			if err := restored.UnmarshalBinary(data); err != nil {
+				t.Fatal(err.Error())
+			}
+			check(when, restored)
+			return restored
+		}
+
+		roundTrip("unfrozen")
+		original.Freeze()
+		roundTrip("frozen")
+		if err := original.AddPattern("more", `{"b": [{"shellstyle": "*zz"}]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+		restored := roundTrip("overlay")
+
+		// the restored instance can be added to and frozen
+		for _, q := range []*Quamina{original, restored} {
+			if err := q.AddPattern("after", `{"b": ["azb"]}`); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		check("added", restored)
+		restored.Freeze()
+		check("refrozen", restored)
+	}
+}
+
+func TestMarshalBinaryErrors(t *testing.T) {
+	q, _ := New()
+	if _, err := q.MarshalBinary(); err == nil {
+		t.Error("marshaled without a codec")
+	}
+	if err := q.UnmarshalBinary(nil); err == nil {
+		t.Error("unmarshaled without a codec")
+	}
+	if _, err := New(WithXCodec(testXCodec{}), WithXCodec(testXCodec{})); err == nil {
+		t.Error("accepted two codecs")
+	}
+
+	q, _ = New(WithXCodec(testXCodec{}))
+	if err := q.AddPattern(1.5, `{"a": ["b"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := q.MarshalBinary(); err == nil {
+		t.Error("marshaled an X the codec can't handle")
+	}
+
+	q, _ = New(WithXCodec(testXCodec{}))
+	if err := q.AddPattern(1, `{"a": ["b"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	data, _ := q.MarshalBinary()
+	other, _ := New(WithXCodec(testXCodec{}), WithNullAsMissing(true))
+	if err := other.UnmarshalBinary(data); err == nil {
+		t.Error("unmarshaled with different options")
+	}
+	if err := other.UnmarshalBinary([]byte("not a matcher")); err == nil {
+		t.Error("unmarshaled garbage")
+	}
+}
+
+func TestMarshalBinaryCorrupt(t *testing.T) {
+	q, _ := New(WithXCodec(testXCodec{}))
+	for i, pattern := range frozenTestPatterns() {
+		if err := q.AddPattern(i, pattern); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	q.Compact()
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	events := frozenTestEvents()
+
+	restored, _ := New(WithXCodec(testXCodec{}))
+	for i := 0; i < len(data); i++ {
+		if err := restored.UnmarshalBinary(data[:i]); err == nil {
+			t.Fatalf("unmarshaled %d of %d bytes", i, len(data))
+		}
+	}
+
+	// corruption that isn't detected mustn't make matching fail
+	rng := rand.New(rand.NewSource(46))
+	corrupt := make([]byte, len(data))
+	for i := 0; i < 2000; i++ {
+		copy(corrupt, data)
+		for j := 0; j < 3; j++ {
+			corrupt[rng.Intn(len(corrupt))] = byte(rng.Intn(256))
+		}
+		if err := restored.UnmarshalBinary(corrupt); err == nil {
+			for _, event := range events {
+				_, _ = restored.MatchesForEvent([]byte(event))
+			}
+		}
+	}
+}
+
+func TestMarshalBinaryDeletion(t *testing.T) {
+	q, _ := New(WithXCodec(testXCodec{}), WithPatternDeletion(true))
+	for _, x := range []string{"a", "b"} {
+		if err := q.AddPattern(x, `{"x": ["1"]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if err := q.DeletePatterns("a"); err != nil {
+		t.Fatal(err.Error())
+	}
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	restored, _ := New(WithXCodec(testXCodec{}), WithPatternDeletion(true))
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := restored.MatchesForEvent([]byte(`{"x": "1"}`))
+	if !sameXs(matches, []X{"b"}) {
+		t.Errorf("matched %v", matches)
+	}
+	if err := restored.DeletePatterns("b"); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ = restored.MatchesForEvent([]byte(`{"x": "1"}`))
+	if len(matches) != 0 {
+		t.Errorf("matched %v after deletion", matches)
+	}
+}
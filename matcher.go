@@ -2,10 +2,15 @@ package quamina
 
 type matcher interface {
 	addPattern(x X, pat string, mode MatcherBuildMode) error
+	addPatterns(specs []PatternSpec, mode MatcherBuildMode) error
 	matchesForFields(fields []Field, bufs *nfaBuffers) ([]X, error)
 	deletePatterns(x X) error
 	getSegmentsTreeTracker() SegmentsTreeTracker
 	getStats() *matcherStats
+	compact()
+	freeze(mode MatcherBuildMode)
+	marshalBinary(codec XCodec, mode MatcherBuildMode) ([]byte, error)
+	unmarshalBinary(data []byte, codec XCodec, mode MatcherBuildMode) error
 }
 
 type matcherStats struct {
@@ -14,4 +19,7 @@ type matcherStats struct {
 	fanouts    int64
 	maxFanout  int64
 	seenStates map[*faState]bool
+
+	maxQuantifier    int64
+	largeQuantifiers int64
 }
@@ -12,7 +12,14 @@ func (m *coreMatcher) getStats() *matcherStats {
 	stats := &matcherStats{
 		seenStates: make(map[*faState]bool),
 	}
-	cmFieldMatcherStats(m.fields().state, stats, nil)
+	fields := m.fields()
+	cmFieldMatcherStats(fields.state, stats, nil)
+	if fields.frozen != nil {
+		stats.states += int64(len(fields.frozen.states))
+		stats.bytes += fields.frozen.memoryCost()
+	}
+	stats.maxQuantifier = int64(fields.maxQuantifier)
+	stats.largeQuantifiers = int64(fields.largeQuantifiers)
 	return stats
 }
 
@@ -23,6 +30,14 @@ func cmFieldMatcherStats(fm *fieldMatcher, stats *matcherStats, pp printer) {
 		if singleton != nil {
 			stats.bytes += int64(cap(singleton))
 		}
+		if literals := vm.fields().literals; literals != nil {
+			stats.bytes += mcLiteralDAWG(literals)
+			for _, transitions := range literals.transitions {
+				for _, trans := range transitions {
+					cmFieldMatcherStats(trans, stats, pp)
+				}
+			}
+		}
 		start := vm.fields().start
 		if start == nil {
 			continue
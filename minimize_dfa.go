@@ -0,0 +1,390 @@
+package quamina
+
+import (
+	"cmp"
+	"encoding/binary"
+	"slices"
+	"unsafe"
+)
+
+// minimizeDFA uses Hopcroft's algorithm to find the minimal DFA equivalent to the one beginning at start.
+// The DFAs that n2dNode builds by subset construction often contain many equivalent states, for example
+// the states reached after different prefixes of a set of wildcard patterns which can then only go on to
+// match the same suffixes. Two states are equivalent if they have the same set of fieldTransitions and,
+// for every byte, transition to equivalent states, so the initial partition groups states by their
+// fieldTransitions and then the algorithm refines it until it's stable.
+// The alphabet is not all 256 byte values, but the ranges of bytes that no smallTable in the DFA
+// distinguishes, which are usually much fewer. A missing transition is treated as a transition to an
+// implicit dead state, and any states equivalent to that are dropped.
+// It returns the new start state and all the states of the new DFA. If the automaton has epsilons, and so
+// isn't a DFA, it returns start and nil.
+func minimizeDFA(start *faState) (*faState, []*faState) {
+	// gather up the states and the boundaries of the byte ranges
+	index := map[*faState]int32{start: 0}
+	states := []*faState{start}
+	var isBoundary [byteCeiling + 1]bool
+	for i := 0; i < len(states); i++ {
+		state := states[i]
+		if len(state.table.epsilons) != 0 || state.isSpinner {
+			return start, nil
+		}
+		for j, step := range state.table.steps {
+			isBoundary[state.table.ceilings[j]] = true
+			if step == nil {
+				continue
+			}
+			if _, ok := index[step]; !ok {
+				index[step] = int32(len(states))
+				states = append(states, step)
+			}
+		}
+	}
+	var symbolFloors []byte
+	floor := 0
+	for b := 1; b <= byteCeiling; b++ {
+		if isBoundary[b] {
+			symbolFloors = append(symbolFloors, byte(floor))
+			floor = b
+		}
+	}
+	k := len(symbolFloors)
+
+	// the transition function, with the dead state numbered n
+	n := len(states)
+	dead := int32(n)
+	delta := make([]int32, (n+1)*k)
+	for i, state := range states {
+		row := delta[i*k : (i+1)*k]
+		r := 0
+		for c, floor := range symbolFloors {
+			for floor >= state.table.ceilings[r] {
+				r++
+			}
+			if step := state.table.steps[r]; step != nil {
+				row[c] = index[step]
+			} else {
+				row[c] = dead
+			}
+		}
+	}
+	for c := 0; c < k; c++ {
+		delta[n*k+c] = dead
+	}
+
+	// the inverse transition function, in compressed-row form: the (symbol, source) pairs for the
+	// transitions into state t are at [invStart[t], invStart[t+1])
+	invStart := make([]int32, n+2)
+	for _, target := range delta {
+		invStart[target+1]++
+	}
+	for t := 1; t <= n+1; t++ {
+		invStart[t] += invStart[t-1]
+	}
+	invSymbol := make([]int32, len(delta))
+	invSource := make([]int32, len(delta))
+	fill := slices.Clone(invStart[:n+1])
+	for i, target := range delta {
+		invSymbol[fill[target]] = int32(i % k)
+		invSource[fill[target]] = int32(i / k)
+		fill[target]++
+	}
+
+	// the initial partition, by fieldTransitions
+	p := newRefinablePartition(n + 1)
+	labels := make(map[string]int32)
+	for i := 0; i <= n; i++ {
+		var key string
+		if i < n {
+			key = fieldTransitionsKey(states[i].fieldTransitions)
+		}
+		block, ok := labels[key]
+		if !ok {
+			block = int32(len(labels))
+			labels[key] = block
+		}
+		p.blockOf[i] = block
+	}
+	p.arrange(len(labels))
+
+	// refine it
+	inWork := make([]bool, len(labels), n+1)
+	work := make([]int32, 0, n+1)
+	for block := range int32(len(labels)) {
+		work = append(work, block)
+		inWork[block] = true
+	}
+	bySymbol := make([][]int32, k)
+	var members []int32
+	for len(work) > 0 {
+		splitter := work[len(work)-1]
+		work = work[:len(work)-1]
+		inWork[splitter] = false
+
+		members = append(members[:0], p.members(splitter)...)
+		for c := range bySymbol {
+			bySymbol[c] = bySymbol[c][:0]
+		}
+		for _, t := range members {
+			for e := invStart[t]; e < invStart[t+1]; e++ {
+				bySymbol[invSymbol[e]] = append(bySymbol[invSymbol[e]], invSource[e])
+			}
+		}
+		for _, sources := range bySymbol {
+			for _, s := range sources {
+				p.mark(s)
+			}
+			for _, split := range p.split() {
+				inWork = append(inWork, false)
+				if inWork[split.old] {
+					work = append(work, split.new)
+					inWork[split.new] = true
+				} else {
+					smaller := split.new
+					if p.size(split.old) < p.size(split.new) {
+						smaller = split.old
+					}
+					work = append(work, smaller)
+					inWork[smaller] = true
+				}
+			}
+		}
+	}
+
+	// build the new DFA, one state per block except the dead state's
+	deadBlock := p.blockOf[dead]
+	newStates := make([]*faState, p.blockCount())
+	for block := range newStates {
+		if int32(block) != deadBlock {
+			newStates[block] = &faState{epsilonClosure: selfOnlyClosure}
+		}
+	}
+	for block, newState := range newStates {
+		if newState == nil {
+			continue
+		}
+		rep := p.members(int32(block))[0]
+		newState.fieldTransitions = dedupFieldTransitions(states[rep].fieldTransitions)
+		row := delta[int(rep)*k : int(rep+1)*k]
+		for c, target := range row {
+			step := newStates[p.blockOf[target]]
+			ceiling := byte(byteCeiling)
+			if c+1 < k {
+				ceiling = symbolFloors[c+1]
+			}
+			last := len(newState.table.steps) - 1
+			if last >= 0 && newState.table.steps[last] == step {
+				newState.table.ceilings[last] = ceiling
+			} else {
+				newState.table.steps = append(newState.table.steps, step)
+				newState.table.ceilings = append(newState.table.ceilings, ceiling)
+			}
+		}
+	}
+
+	newStart := newStates[p.blockOf[0]]
+	if newStart == nil {
+		// nothing can be matched
+		newStart = &faState{table: newSmallTable(), epsilonClosure: selfOnlyClosure}
+		return newStart, []*faState{newStart}
+	}
+	reachable := make([]*faState, 0, len(newStates))
+	for _, state := range newStates {
+		if state != nil {
+			reachable = append(reachable, state)
+		}
+	}
+	return newStart, reachable
+}
+
+// dedupFieldTransitions returns the distinct members of fms, sorted by address
+func dedupFieldTransitions(fms []*fieldMatcher) []*fieldMatcher {
+	if len(fms) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(fms)
+	slices.SortFunc(sorted, func(a, b *fieldMatcher) int {
+		return cmp.Compare(uintptr(unsafe.Pointer(a)), uintptr(unsafe.Pointer(b)))
+	})
+	return slices.Clip(slices.Compact(sorted))
+}
+
+// fieldTransitionsKey identifies the set of fieldMatchers in fms, ignoring order and duplicates
+func fieldTransitionsKey(fms []*fieldMatcher) string {
+	sorted := dedupFieldTransitions(fms)
+	key := make([]byte, 8*len(sorted))
+	for i, fm := range sorted {
+		binary.LittleEndian.PutUint64(key[i*8:], uint64(uintptr(unsafe.Pointer(fm))))
+	}
+	return string(key)
+}
+
+// refinablePartition is the data structure from Valmari & Lehtinen's formulation of Hopcroft's algorithm.
+// The elements are kept in one array in which each block occupies a contiguous range; marking an element
+// moves it to the front of its block's range, so splitting off the marked elements is cheap.
+type refinablePartition struct {
+	elements []int32 // grouped by block
+	location []int32 // the index of each element in elements
+	blockOf  []int32
+	first    []int32 // the start of each block's range in elements
+	end      []int32 // the end of each block's range
+	mid      []int32 // the end of the marked elements at the start of each block's range
+	touched  []int32 // the blocks with marked elements
+	splits   []partitionSplit
+}
+
+type partitionSplit struct {
+	old, new int32
+}
+
+func newRefinablePartition(size int) *refinablePartition {
+	return &refinablePartition{
+		elements: make([]int32, size),
+		location: make([]int32, size),
+		blockOf:  make([]int32, size),
+	}
+}
+
+// arrange sets up the block ranges once blockOf has been filled in
+func (p *refinablePartition) arrange(blockCount int) {
+	p.first = make([]int32, blockCount)
+	p.end = make([]int32, blockCount)
+	for _, block := range p.blockOf {
+		p.end[block]++
+	}
+	var start int32
+	for block := range p.first {
+		p.first[block] = start
+		start += p.end[block]
+		p.end[block] = p.first[block]
+	}
+	for element, block := range p.blockOf {
+		p.elements[p.end[block]] = int32(element)
+		p.location[element] = p.end[block]
+		p.end[block]++
+	}
+	p.mid = slices.Clone(p.first)
+}
+
+func (p *refinablePartition) blockCount() int {
+	return len(p.first)
+}
+
+func (p *refinablePartition) size(block int32) int32 {
+	return p.end[block] - p.first[block]
+}
+
+func (p *refinablePartition) members(block int32) []int32 {
+	return p.elements[p.first[block]:p.end[block]]
+}
+
+// mark marks an element, unless it already is
+func (p *refinablePartition) mark(element int32) {
+	block := p.blockOf[element]
+	loc := p.location[element]
+	mid := p.mid[block]
+	if loc < mid {
+		return
+	}
+	if mid == p.first[block] {
+		p.touched = append(p.touched, block)
+	}
+	other := p.elements[mid]
+	p.elements[loc], p.elements[mid] = other, element
+	p.location[other], p.location[element] = loc, mid
+	p.mid[block]++
+}
+
+// split divides each block which has both marked and unmarked elements in two, with the marked elements
+// in the new block, and clears the marks. It returns the splits it made.
+func (p *refinablePartition) split() []partitionSplit {
+	p.splits = p.splits[:0]
+	for _, block := range p.touched {
+		mid := p.mid[block]
+		if mid == p.end[block] {
+			// all marked, nothing to split
+			p.mid[block] = p.first[block]
+			continue
+		}
+		newBlock := int32(len(p.first))
+		p.first = append(p.first, p.first[block])
+		p.end = append(p.end, mid)
+		p.mid = append(p.mid, p.first[block])
+		p.first[block] = mid
+		p.mid[block] = mid
+		for _, element := range p.members(newBlock) {
+			p.blockOf[element] = newBlock
+		}
+		p.splits = append(p.splits, partitionSplit{old: block, new: newBlock})
+	}
+	p.touched = p.touched[:0]
+	return p.splits
+}
+
+// compactFieldMatcher calls compact on every valueMatcher reachable from fm
+func compactFieldMatcher(fm *fieldMatcher, seen map[*fieldMatcher]bool) {
+	if seen[fm] {
+		return
+	}
+	seen[fm] = true
+	fields := fm.fields()
+	for _, vm := range fields.transitions {
+		vm.compressLiterals()
+		vm.compact()
+		vmFields := vm.fields()
+		if vmFields.literals != nil {
+			for _, transitions := range vmFields.literals.transitions {
+				for _, next := range transitions {
+					compactFieldMatcher(next, seen)
+				}
+			}
+		}
+		if vmFields.singletonTransition != nil {
+			compactFieldMatcher(vmFields.singletonTransition, seen)
+		}
+		for _, p := range vmFields.predicates {
+			compactFieldMatcher(p.next, seen)
+		}
+		if vmFields.start != nil {
+			for _, next := range reachableFieldTransitions(vmFields.start) {
+				compactFieldMatcher(next, seen)
+			}
+		}
+	}
+	for _, next := range fields.existsTrue {
+		compactFieldMatcher(next, seen)
+	}
+	for _, next := range fields.existsFalse {
+		compactFieldMatcher(next, seen)
+	}
+	for _, next := range fields.existsFalseIgnoringNull {
+		compactFieldMatcher(next, seen)
+	}
+	for _, byRef := range fields.equalsField {
+		for _, next := range byRef {
+			compactFieldMatcher(next, seen)
+		}
+	}
+}
+
+// reachableFieldTransitions returns the fieldTransitions of all the states in the automaton at start
+func reachableFieldTransitions(start *faState) []*fieldMatcher {
+	var fms []*fieldMatcher
+	seen := map[*faState]bool{start: true}
+	states := []*faState{start}
+	visit := func(next *faState) {
+		if next != nil && !seen[next] {
+			seen[next] = true
+			states = append(states, next)
+		}
+	}
+	for i := 0; i < len(states); i++ {
+		fms = append(fms, states[i].fieldTransitions...)
+		for _, next := range states[i].table.steps {
+			visit(next)
+		}
+		for _, next := range states[i].table.epsilons {
+			visit(next)
+		}
+	}
+	return fms
+}
@@ -0,0 +1,111 @@
+package quamina
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMinimizeDFA(t *testing.T) {
+	tests := []struct {
+		pattern string
+		shoulds []string
+		nopes   []string
+	}{
+		{"*abc", []string{"abc", "fooabc", "abcabc"}, []string{"abd", "fooac"}},
+		{"a*bc", []string{"abc", "axybc", "abcbc"}, []string{"abd", "fooac"}},
+		{"*foo*bar*", []string{"foobar", "xfooybar", "foobarbaz"}, []string{"barfoo", "foo", "fobar"}},
+		{"*a*b*c*d*e*", []string{"abcde", "xaxbxcxdxex", "aabbccddee"}, []string{"abcd", "edcba", "abce"}},
+	}
+	for _, test := range tests {
+		nfa, _ := makeShellStyleFA(asQuotedBytes(t, test.pattern), sharedNullPrinter)
+		epsilonClosure(nfa)
+		dfa := nfa2Dfa(nfa)
+		minimal, states := minimizeDFA(dfa)
+		if states == nil {
+			t.Fatalf("%s: not minimized", test.pattern)
+		}
+		before := &matcherStats{seenStates: make(map[*faState]bool)}
+		cmStateStats(dfa, before, nil)
+		if int64(len(states)) > before.states {
+			t.Errorf("%s: %d states grew to %d", test.pattern, before.states, len(states))
+		}
+		for _, should := range test.shoulds {
+			if len(traverseDFA(minimal, asQuotedBytes(t, should), nil)) == 0 {
+				t.Errorf("%s didn't match %s", test.pattern, should)
+			}
+		}
+		for _, nope := range test.nopes {
+			if len(traverseDFA(minimal, asQuotedBytes(t, nope), nil)) != 0 {
+				t.Errorf("%s matched %s", test.pattern, nope)
+			}
+		}
+
+		// minimizing a minimal DFA changes nothing
+		_, again := minimizeDFA(minimal)
+		if len(again) != len(states) {
+			t.Errorf("%s: re-minimizing %d states gave %d", test.pattern, len(states), len(again))
+		}
+	}
+
+	// not a DFA
+	nfa, _ := makeShellStyleFA(asQuotedBytes(t, "a*b"), sharedNullPrinter)
+	start, states := minimizeDFA(nfa)
+	if start != nfa || states != nil {
+		t.Error("minimized an NFA")
+	}
+}
+
+func TestCompact(t *testing.T) {
+	comfort, _ := New()
+	speed, _ := New()
+	_ = speed.SetMatcherBuildMode(BuiltForSpeed)
+	addAll := func(from, to int) {
+		for i := from; i < to; i++ {
+			pattern := fmt.Sprintf(`{"x": [ {"wildcard": "*%d*z"} ], "y": [ {"shellstyle": "a*%d"} ]}`, i%3, i)
+			if err := comfort.AddPattern(i, pattern); err != nil {
+				t.Fatal(err)
+			}
+			if err := speed.AddPattern(i, pattern); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	var events [][]byte
+	for i := 0; i < 25; i++ {
+		events = append(events, []byte(fmt.Sprintf(`{"x": "q%dz", "y": "ab%d"}`, i, i)))
+		events = append(events, []byte(fmt.Sprintf(`{"x": "%d", "y": "a%d"}`, i, i*3)))
+	}
+	check := func(when string) {
+		t.Helper()
+		for _, event := range events {
+			want, _ := comfort.MatchesForEvent(event)
+			got, _ := speed.MatchesForEvent(event)
+			if !sameXs(want, got) {
+				t.Errorf("%s, %s: comfort %v speed %v", when, event, want, got)
+			}
+		}
+	}
+
+	addAll(0, 15)
+	check("before")
+	before := speed.GetMatcherStats()
+	speed.Compact()
+	after := speed.GetMatcherStats()
+	check("after")
+	if after["bytes"] >= before["bytes"] {
+		t.Errorf("compaction didn't shrink %.0f bytes, got %.0f", before["bytes"], after["bytes"])
+	}
+
+	// incremental conversion has to keep working on the compacted DFAs
+	addAll(15, 20)
+	check("added")
+	speed.Compact()
+	check("compacted again")
+
+	// no DFAs to compact
+	comfortBytes := comfort.GetMatcherStats()["bytes"]
+	comfort.Compact()
+	if comfort.GetMatcherStats()["bytes"] != comfortBytes {
+		t.Error("NFAs changed by compaction")
+	}
+}
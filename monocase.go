@@ -3,6 +3,7 @@ package quamina
 import (
 	"errors"
 	"fmt"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -85,3 +86,55 @@ func makeMonocaseFA(val []byte, pp printer) (*faState, *fieldMatcher) {
 	nextStep.table.addByteStep(valueTerminator, lastState)
 	return startState, fm
 }
+
+// foldCase appends to buf a case-folded version of b, suitable for comparing member names without regard
+// to case, and returns the result. In the common case where b needs no folding, b itself is returned and buf
+// is untouched. Bytes which are not valid UTF-8 are passed through unchanged.
+func foldCase(buf []byte, b []byte) []byte {
+	// fast path: find the first byte that needs attention
+	first := -1
+	for i, c := range b {
+		if c >= utf8.RuneSelf || (c >= 'A' && c <= 'Z') {
+			first = i
+			break
+		}
+	}
+	if first == -1 {
+		return b
+	}
+	buf = append(buf, b[:first]...)
+	for i := first; i < len(b); {
+		c := b[i]
+		if c < utf8.RuneSelf {
+			if c >= 'A' && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			buf = append(buf, c)
+			i++
+			continue
+		}
+		r, width := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && width == 1 {
+			buf = append(buf, c)
+			i++
+			continue
+		}
+		buf = utf8.AppendRune(buf, foldRune(r))
+		i += width
+	}
+	return buf
+}
+
+// foldRune returns a canonical member of the set of runes which are equivalent to r under Unicode simple case
+// folding. caseFoldingPairs can't be used for this because it only records one alternate per rune, and some
+// sets, for example Σ σ ς, have three members. So we walk the whole set with unicode.SimpleFold, take its
+// lowest member, and return the lower-case form of that, which agrees with the ASCII fast path in foldCase.
+func foldRune(r rune) rune {
+	lowest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < lowest {
+			lowest = f
+		}
+	}
+	return unicode.ToLower(lowest)
+}
@@ -110,3 +110,34 @@ func TestEqualsIgnoreCaseMatching(t *testing.T) {
 		t.Error("wrong on ABCXYZ")
 	}
 }
+
+func TestFoldCase(t *testing.T) {
+	unchanged := []string{"", "abc", "user_id", "a1-b2"}
+	for _, u := range unchanged {
+		in := []byte(u)
+		out := foldCase(nil, in)
+		if string(out) != u {
+			t.Errorf("%s folded to %s", u, out)
+		}
+	}
+	pairs := [][]string{
+		{"UserID", "userid"},
+		{"ΣΊΣΥΦΟΣ", "σίσυφος"},
+		{"Größe", "größe"},
+		{"ÉCOLE", "école"},
+		{"Σ", "ς"},
+		{"σ", "ς"},
+		{"K", "k"},
+	}
+	for _, pair := range pairs {
+		out := foldCase(nil, []byte(pair[0]))
+		if string(out) != string(foldCase(nil, []byte(pair[1]))) {
+			t.Errorf("%s folded to %s, %s to %s", pair[0], out, pair[1], foldCase(nil, []byte(pair[1])))
+		}
+	}
+	bad := []byte{'A', 0xff, 'B'}
+	out := foldCase(nil, bad)
+	if string(out) != string([]byte{'a', 0xff, 'b'}) {
+		t.Errorf("bad UTF-8 folded to %v", out)
+	}
+}
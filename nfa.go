@@ -2,6 +2,7 @@ package quamina
 
 import (
 	"fmt"
+	"slices"
 	"unsafe"
 )
 
@@ -23,6 +24,13 @@ type faState struct {
 	isSpinner      bool
 }
 
+// isPassThrough reports whether a state does nothing but lead to other states by epsilon transitions. Such
+// states can be skipped when computing closures and simplifying splices; a state with fieldTransitions can't,
+// even if its table is epsilon-only, because reaching it is a match.
+func (s *faState) isPassThrough() bool {
+	return s.table.isEpsilonOnly() && len(s.fieldTransitions) == 0
+}
+
 /*
 Here's the problem. When you have the shellstyle *, which really means ".*", there are options on how
 to implement, and they have effect on what you can do while merging, with the results highlighted by
@@ -100,6 +108,12 @@ type nfaBuffers struct {
 	transmap       *transmap
 	fieldSet       map[*fieldMatcher]bool
 	qNumBuf        [MaxBytesInEncoding]byte
+	frozen         *frozenBuffers
+	fieldMatch     fieldMatch[*fieldMatcher]
+	// lazyDFAs is the budget for the states built by the lazyDFAs of the matcher being used; see lazy_dfa.go
+	lazyDFAs *lazyDFABudget
+	// exactIntegers is set if the matcher being used matches integers exactly; see equals_field.go
+	exactIntegers bool
 }
 
 func newNfaBuffers() *nfaBuffers {
@@ -157,6 +171,29 @@ func nfa2Dfa(nfaStart *faState) *faState {
 	return n2dNode(startNfa, newStateLists())
 }
 
+// incrementalNfa2Dfa is nfa2Dfa for use in BuiltForSpeed mode, where AddPattern merges a new NFA into the
+// DFA built by the previous conversion. Rather than reconverting the whole automaton, it passes in the
+// stateLists from that conversion, so n2dNode stops wherever the new NFA's states have all dropped out and
+// only the DFA states from before remain. Thus the work done is proportional to the part of the automaton
+// affected by the new pattern. It returns the DFA and the stateLists to pass to the next conversion, which
+// is nil for the first.
+func incrementalNfa2Dfa(nfaStart *faState, sList *stateLists) (*faState, *stateLists) {
+	// DFA states which the merge has replaced with new ones stay in sList, so once that might account for
+	// half of it, start afresh. That costs a full conversion, but only after the number of DFA states has
+	// doubled, so the amortized cost stays proportional to the work done incrementally.
+	fresh := sList == nil || len(sList.entries) > 2*sList.fullSize
+	if fresh {
+		sList = newStateLists()
+	}
+	nfaStart.epsilonClosure = selfOnlyClosure
+	dfaStart := n2dNode([]*faState{nfaStart}, sList)
+	sList.forgetNFASets()
+	if fresh {
+		sList.fullSize = len(sList.entries)
+	}
+	return dfaStart, sList
+}
+
 // n2dNode input is a list of NFA states, which are all the states that are either the
 // singleton start state or the states that can be reached from a previous state on
 // a byte transition.
@@ -180,6 +217,10 @@ func n2dNode(rawNStates []*faState, sList *stateLists) *faState {
 	if alreadyExists {
 		return dfaState
 	}
+	// DFA states have no epsilons, so the epsilon closure is trivial, and recording that means later
+	// epsilon-closure walks stop here
+	dfaState.epsilonClosure = selfOnlyClosure
+	sList.addDFAState(dfaState)
 
 	// OK, this is a new set of states, so we have to consider all the possible byte
 	// transitions and, for each, aggregate all the states that could be reached on seeing
@@ -336,12 +377,18 @@ func traverseNFA(start *faState, val []byte, transitions []*fieldMatcher, bufs *
 	bufs.buf1 = currentStates[:0]
 	bufs.buf2 = nextStates[:0]
 
-	// Materialize into current transmap buffer
+	return bufs.transitionsFromFieldSet(fieldSet)
+}
+
+// transitionsFromFieldSet materializes the members of fieldSet into the current transmap buffer
+func (nb *nfaBuffers) transitionsFromFieldSet(fieldSet map[*fieldMatcher]bool) []*fieldMatcher {
 	if len(fieldSet) == 0 {
 		return nil
 	}
-	tm := bufs.getTransmap()
-	buf := tm.levels[tm.depth] // already [:0] from push()
+	tm := nb.getTransmap()
+	// usually already [:0] from push(), but not if this is a second traversal for the same field, in which
+	// case the previous results, which may be in this buffer, have already been copied into fieldSet
+	buf := tm.levels[tm.depth][:0]
 	for fm := range fieldSet {
 		buf = append(buf, fm)
 	}
@@ -361,7 +408,7 @@ func makeFaStepKey(s1, s2 *faState) faStepKey {
 	return faStepKey{s2, s1}
 }
 
-// simplifySplices collects all non-epsilon-only states reachable via
+// simplifySplices collects all non-pass-through states reachable via
 // epsilon transitions from state1 and state2. This prevents deep nesting of
 // splice states that would otherwise accumulate during repeated merges.
 func simplifySplices(state1, state2 *faState) []*faState {
@@ -381,7 +428,7 @@ func simplifyCollect(s *faState, visited map[*faState]bool, targets []*faState)
 	}
 	visited[s] = true
 
-	if s.table.isEpsilonOnly() {
+	if s.isPassThrough() {
 		for _, eps := range s.table.epsilons {
 			targets = simplifyCollect(eps, visited, targets)
 		}
@@ -536,13 +583,16 @@ func asymmetricSpinnerMerge(spinner, nonSpinner *faState, keyMemo map[faStepKey]
 		case spinnerNext == spinner:
 			// nonspinner has a branch here
 			// if the current spinner value is a loopback, we need to make a new state whose value
-			// is the nonspinner with the addition of the epsilon link back to the spinner
+			// is the nonspinner with the addition of the epsilon link back to the spinner. The nonspinner's
+			// fieldTransitions come along, since it may be a state which matches as soon as it's reached,
+			// as in anything-but; the spinner's are reached through the epsilon. The epsilons are copied
+			// because the nonspinner's are still in use.
 			mergedTable := smallTable{
 				steps:    nonSpinnernext.table.steps,
 				ceilings: nonSpinnernext.table.ceilings,
-				epsilons: append(nonSpinnernext.table.epsilons, spinner),
+				epsilons: append(slices.Clip(nonSpinnernext.table.epsilons), spinner),
 			}
-			mergedState = &faState{table: mergedTable, fieldTransitions: spinner.fieldTransitions}
+			mergedState = &faState{table: mergedTable, fieldTransitions: nonSpinnernext.fieldTransitions}
 
 		default:
 			// if spinner's branch isn't a loopback, we need to merge its target with the nonspinner
@@ -605,7 +655,7 @@ func symmetricSpinnerMerge(state1, state2 *faState, keyMemo map[faStepKey]*faSta
 			table := smallTable{
 				ceilings: next2.table.ceilings,
 				steps:    next2.table.steps,
-				epsilons: append(state2.table.epsilons, combined),
+				epsilons: append(slices.Clip(state2.table.epsilons), combined),
 			}
 			mergedState = &faState{
 				table:            table,
@@ -616,7 +666,7 @@ func symmetricSpinnerMerge(state1, state2 *faState, keyMemo map[faStepKey]*faSta
 			table := smallTable{
 				ceilings: next1.table.ceilings,
 				steps:    next1.table.steps,
-				epsilons: append(state1.table.epsilons, combined),
+				epsilons: append(slices.Clip(state1.table.epsilons), combined),
 			}
 			mergedState = &faState{
 				table:            table,
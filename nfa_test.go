@@ -199,6 +199,56 @@ func TestNfa2Dfa(t *testing.T) {
 		}
 	}
 }
+
+// TestIncrementalNfa2Dfa checks that in BuiltForSpeed mode, adding a pattern only converts the part of the
+// automaton it affects, and that the results are the same as in BuiltForComfort mode
+func TestIncrementalNfa2Dfa(t *testing.T) {
+	comfort := newCoreMatcher()
+	speed := newCoreMatcher()
+	var newStates []int
+	for i := 0; i < 200; i++ {
+		pattern := fmt.Sprintf(`{"x": [ {"shellstyle": "p%d-*-end"} ]}`, i)
+		if err := comfort.addPattern(i, pattern, BuiltForComfort); err != nil {
+			t.Fatal(err)
+		}
+		before := 0
+		if vm, ok := speed.fields().state.fields().transitions["x"]; ok && vm.fields().dfaStates != nil {
+			before = len(vm.fields().dfaStates.entries)
+		}
+		if err := speed.addPattern(i, pattern, BuiltForSpeed); err != nil {
+			t.Fatal(err)
+		}
+		vm := speed.fields().state.fields().transitions["x"]
+		if vm.fields().isNondeterministic {
+			t.Fatal("not converted to DFA")
+		}
+		if vm.fields().dfaStates != nil {
+			newStates = append(newStates, len(vm.fields().dfaStates.entries)-before)
+		}
+	}
+
+	// except when a full conversion happens, the number of new DFA states should depend on the
+	// pattern, not on the number of patterns already present
+	small := 0
+	for _, n := range newStates {
+		if n > 0 && n < 20 {
+			small++
+		}
+	}
+	if small < len(newStates)*9/10 {
+		t.Errorf("only %d of %d conversions incremental: %v", small, len(newStates), newStates)
+	}
+
+	for _, e := range []string{"p7-x-end", "p199--end", "p12-end", "p200-x-end", "p1-abc-end-end", "p33"} {
+		event := []byte(fmt.Sprintf(`{"x": "%s"}`, e))
+		want, _ := comfort.matchesForJSONEvent(event)
+		got, _ := speed.matchesForJSONEvent(event)
+		if !sameXs(want, got) {
+			t.Errorf("%s: comfort %v speed %v", e, want, got)
+		}
+	}
+}
+
 func asQuotedBytes(t *testing.T, s string) []byte {
 	t.Helper()
 	s = `"` + s + `"`
@@ -0,0 +1,81 @@
+package quamina
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// By default, a field whose value is null exists, so it matches exists:true but not exists:false, while a
+// field whose value is an empty array or an object doesn't, because there is no leaf value. The is-null and
+// is-missing patterns, and the WithNullAsMissing option, allow patterns to be more explicit.
+//
+// {"is-null": true} matches a field whose value is null. It is exactly the same as the pattern [null].
+//
+// {"is-missing": true} matches only if the member doesn't appear in the event at all. It is implemented as an
+// exists:false test on the field plus an exists:false test on a synthetic field whose path is the field's
+// path extended by presenceMarker. When the flattener finds a member whose value is an object or array, and the
+// segmentsTree says there is such a synthetic field, it reports a Field with that path. Thus, the member's
+// presence causes is-missing to fail whether or not it has any leaf values.
+
+// presenceMarker is the last segment of the paths used to implement is-missing. It's a byte that
+// can't occur in UTF-8.
+const presenceMarker = "\xf9"
+
+// readIsNullSpecial reads {"is-null": true}
+func readIsNullSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	pathVals = valsIn
+	err = readTrueOperand(pb, "is-null")
+	if err != nil {
+		return
+	}
+	pathVals = append(pathVals, typedVal{vType: literalType, val: "null"})
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
+
+// readIsMissingSpecial reads {"is-missing": true}. As well as the exists:false value for the field, it adds
+// a patternField for the presence marker to pb.results.
+func readIsMissingSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	pathVals = valsIn
+	err = readTrueOperand(pb, "is-missing")
+	if err != nil {
+		return
+	}
+	pathVals = append(pathVals, typedVal{vType: existsFalseType})
+	markerPath := append(slices.Clone(pb.path), presenceMarker)
+	pb.results = append(pb.results, &patternField{
+		path: strings.Join(markerPath, SegmentSeparator),
+		vals: []typedVal{{vType: existsFalseType}},
+	})
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
+
+func readTrueOperand(pb *patternBuild, name string) error {
+	t, err := pb.jd.Token()
+	if err != nil {
+		return err
+	}
+	b, ok := t.(bool)
+	if !ok || !b {
+		return errors.New("value for '" + name + "' must be true")
+	}
+	return nil
+}
+
+// storePresenceMarker is called by the flattener when it finds a member whose value is an object or array
+// and whose name maps to the node in the segmentsTree, to report its presence if an is-missing pattern
+// needs to know.
+func (fj *flattenJSON) storePresenceMarker(node SegmentsTreeTracker, arrayTrail []ArrayPos) {
+	path := node.PathForSegment(presenceMarkerBytes)
+	if path != nil {
+		fj.storeObjectMemberField(path, arrayTrail, trueBytes, false)
+	}
+}
+
+var presenceMarkerBytes = []byte(presenceMarker)
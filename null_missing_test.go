@@ -0,0 +1,125 @@
+package quamina
+
+import (
+	"testing"
+)
+
+type nullMissingCase struct {
+	event   string
+	matches []string
+}
+
+func checkNullMissingCases(t *testing.T, q *Quamina, label string, cases []nullMissingCase) {
+	t.Helper()
+	for _, c := range cases {
+		matches, err := q.MatchesForEvent([]byte(c.event))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(matches) != len(c.matches) {
+			t.Errorf("%s %s: wanted %v got %v", label, c.event, c.matches, matches)
+			continue
+		}
+		for _, want := range c.matches {
+			if !containsX(matches, want) {
+				t.Errorf("%s %s: wanted %v got %v", label, c.event, c.matches, matches)
+			}
+		}
+	}
+}
+
+func addNullMissingPatterns(t *testing.T, q *Quamina) {
+	t.Helper()
+	patterns := map[string]string{
+		"isNull":      `{"a": [{"is-null": true}]}`,
+		"isMissing":   `{"a": [{"is-missing": true}]}`,
+		"existsTrue":  `{"a": [{"exists": true}]}`,
+		"existsFalse": `{"a": [{"exists": false}]}`,
+	}
+	for name, pattern := range patterns {
+		if err := q.AddPattern(name, pattern); err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+	}
+}
+
+func TestIsNullIsMissing(t *testing.T) {
+	q, _ := New()
+	addNullMissingPatterns(t, q)
+	checkNullMissingCases(t, q, "default", []nullMissingCase{
+		{`{"a": null}`, []string{"isNull", "existsTrue"}},
+		{`{"a": 1}`, []string{"existsTrue"}},
+		{`{"b": 1}`, []string{"isMissing", "existsFalse"}},
+		{`{}`, []string{"isMissing", "existsFalse"}},
+		{`{"a": []}`, []string{"existsFalse"}},
+		{`{"a": {}}`, []string{"existsFalse"}},
+		{`{"a": {"b": 2}}`, []string{"existsFalse"}},
+		{`{"a": [null, 1]}`, []string{"isNull", "existsTrue"}},
+		{`{"a": [{"b": 1}]}`, []string{"existsFalse"}},
+	})
+}
+
+func TestNullAsMissing(t *testing.T) {
+	_, err := New(WithNullAsMissing(true), WithNullAsMissing(false))
+	if err == nil {
+		t.Error("allowed 2 WithNullAsMissing")
+	}
+	q, _ := New(WithNullAsMissing(true))
+	addNullMissingPatterns(t, q)
+	checkNullMissingCases(t, q, "null-as-missing", []nullMissingCase{
+		{`{"a": null}`, []string{"isNull", "existsFalse"}},
+		{`{"a": 1}`, []string{"existsTrue"}},
+		{`{"b": 1}`, []string{"isMissing", "existsFalse"}},
+		{`{"a": []}`, []string{"existsFalse"}},
+		{`{"a": [null, null]}`, []string{"isNull", "existsFalse"}},
+		{`{"a": [null, 1]}`, []string{"isNull", "existsTrue"}},
+	})
+
+	// exists:false followed by other fields, where the null is skipped
+	q, _ = New(WithNullAsMissing(true))
+	if err := q.AddPattern("p", `{"a": [{"exists": false}], "b": [1]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	checkNullMissingCases(t, q, "followed", []nullMissingCase{
+		{`{"a": null, "b": 1}`, []string{"p"}},
+		{`{"b": 1}`, []string{"p"}},
+		{`{"a": 0, "b": 1}`, []string{}},
+	})
+
+	// chained exists:false, where the skipped null is the last field
+	q, _ = New(WithNullAsMissing(true))
+	if err := q.AddPattern("p", `{"a": [{"exists": false}], "b": [{"exists": false}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	chained := []nullMissingCase{
+		{`{}`, []string{"p"}},
+		{`{"a": null}`, []string{"p"}},
+		{`{"b": null}`, []string{"p"}},
+		{`{"a": null, "b": null}`, []string{"p"}},
+		{`{"a": null, "b": 1}`, []string{}},
+	}
+	checkNullMissingCases(t, q, "chained", chained)
+	q.Freeze()
+	checkNullMissingCases(t, q, "chained frozen", chained)
+}
+
+func TestIsNullIsMissingSyntax(t *testing.T) {
+	bad := []string{
+		`{"a": [{"is-null": false}]}`,
+		`{"a": [{"is-null": "x"}]}`,
+		`{"a": [{"is-missing": false}]}`,
+		`{"a": [{"is-missing": true}, 3]}`,
+	}
+	for _, b := range bad {
+		if _, err := patternFromJSON([]byte(b)); err == nil {
+			t.Errorf("accepted %s", b)
+		}
+	}
+	fields, err := patternFromJSON([]byte(`{"x": {"a": [{"is-missing": true}]}}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(fields) != 2 {
+		t.Errorf("wanted 2 fields, got %d", len(fields))
+	}
+}
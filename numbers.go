@@ -2,6 +2,7 @@ package quamina
 
 import (
 	"errors"
+	"math/big"
 	"strconv"
 )
 
@@ -62,3 +63,97 @@ func (q qNumber) String() string {
 	return ret
 }
 */
+
+// isJSONNumber checks whether the bytes conform to the grammar for numbers in RFC 8259
+func isJSONNumber(b []byte) bool {
+	i := 0
+	if i < len(b) && b[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(b) && b[i] == '0':
+		i++
+	case i < len(b) && b[i] >= '1' && b[i] <= '9':
+		for i < len(b) && b[i] >= '0' && b[i] <= '9' {
+			i++
+		}
+	default:
+		return false
+	}
+	if i < len(b) && b[i] == '.' {
+		i++
+		start := i
+		for i < len(b) && b[i] >= '0' && b[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	if i < len(b) && (b[i] == 'e' || b[i] == 'E') {
+		i++
+		if i < len(b) && (b[i] == '+' || b[i] == '-') {
+			i++
+		}
+		start := i
+		for i < len(b) && b[i] >= '0' && b[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	return i == len(b)
+}
+
+// Q numbers are float64 values, so integers whose magnitude exceeds 2^53 lose precision and distinct values,
+// for example 9007199254740992 and 9007199254740993, can produce the same Q number. When the WithExactIntegers
+// option is in effect, such integers are represented instead by their canonical decimal form, with no leading
+// zeroes, fraction, or exponent. These are at least 16 bytes long, while a Q number is at most MaxBytesInEncoding,
+// so the two forms can't collide in an automaton.
+
+// maxExactIntegerExponent limits the size of the exponent in numbers like 1e1000 for which exactIntegerForm will
+// compute the canonical decimal form, so that a short number can't cause a huge allocation.
+const maxExactIntegerExponent = 1000
+
+var maxFloatInteger = new(big.Int).Lsh(big.NewInt(1), 53)
+
+// exactIntegerForm checks whether the bytes, which must conform to the JSON number grammar, represent an
+// integer which is too large in magnitude to be represented exactly as a float64. If so, it returns the
+// integer's canonical decimal form.
+func exactIntegerForm(b []byte) ([]byte, bool) {
+	// fast path: integers of no more than 15 digits, with no fraction or exponent, are less than 2^53
+	digits := 0
+	exponent := -1
+	for i, c := range b {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.':
+			digits = maxFloatDigits + 1
+		case c == 'e' || c == 'E':
+			exponent = i + 1
+		}
+		if exponent != -1 {
+			break
+		}
+	}
+	if exponent == -1 && digits <= maxFloatDigits {
+		return nil, false
+	}
+	if exponent != -1 {
+		e, err := strconv.Atoi(string(b[exponent:]))
+		if err != nil || e > maxExactIntegerExponent || e < -maxExactIntegerExponent {
+			return nil, false
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(string(b))
+	if !ok || !r.IsInt() || r.Num().CmpAbs(maxFloatInteger) <= 0 {
+		return nil, false
+	}
+	return r.Num().Append(nil, 10), true
+}
+
+// maxFloatDigits is the number of decimal digits below which all integers are exactly representable as float64
+const maxFloatDigits = 15
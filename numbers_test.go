@@ -207,3 +207,175 @@ func TestMatcherNumerics(t *testing.T) {
 		}
 	}
 }
+
+func TestIsJSONNumber(t *testing.T) {
+	good := []string{"0", "-0", "1", "21.5", "-3.25e10", "1E+2", "6e-3", "0.0"}
+	bad := []string{"", "-", "01", "1.", ".5", "1e", "1e+", "+1", " 1", "1 ", "0x10", "NaN", "1.2.3", "--1"}
+	for _, g := range good {
+		if !isJSONNumber([]byte(g)) {
+			t.Errorf("rejected %q", g)
+		}
+	}
+	for _, b := range bad {
+		if isJSONNumber([]byte(b)) {
+			t.Errorf("accepted %q", b)
+		}
+	}
+}
+
+func TestNumericStrings(t *testing.T) {
+	_, err := New(WithNumericStrings(true), WithNumericStrings(true))
+	if err == nil {
+		t.Error("allowed 2 WithNumericStrings")
+	}
+
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{"temp", `{"temperature": [21.5, 30]}`},
+		{"tempString", `{"temperature": ["21.5"]}`},
+		{"tempWild", `{"temperature": [{"wildcard": "2*"}]}`},
+		{"code", `{"code": ["007"]}`},
+	}
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		q, _ := New(WithNumericStrings(true))
+		_ = q.SetMatcherBuildMode(mode)
+		for _, p := range patterns {
+			if err := q.AddPattern(p.name, p.pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		events := []struct {
+			event   string
+			matches []string
+		}{
+			{`{"temperature": 21.5}`, []string{"temp"}},
+			{`{"temperature": "21.5"}`, []string{"temp", "tempString", "tempWild"}},
+			{`{"temperature": "2.15e1"}`, []string{"temp", "tempWild"}},
+			{`{"temperature": "30.0"}`, []string{"temp"}},
+			{`{"temperature": " 30"}`, []string{}},
+			{`{"temperature": "21.5C"}`, []string{"tempWild"}},
+			{`{"code": "007"}`, []string{"code"}},
+		}
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if len(matches) != len(e.matches) {
+				t.Errorf("mode %d %s: wanted %v got %v", mode, e.event, e.matches, matches)
+				continue
+			}
+			for _, want := range e.matches {
+				if !containsX(matches, want) {
+					t.Errorf("mode %d %s: wanted %v got %v", mode, e.event, e.matches, matches)
+				}
+			}
+		}
+	}
+
+	// off by default
+	q, _ := New()
+	_ = q.AddPattern("temp", `{"temperature": [21.5]}`)
+	matches, _ := q.MatchesForEvent([]byte(`{"temperature": "21.5"}`))
+	if len(matches) != 0 {
+		t.Errorf("numeric string matched by default: %v", matches)
+	}
+}
+
+func TestExactIntegerForm(t *testing.T) {
+	tests := []struct {
+		in    string
+		exact string
+	}{
+		{"9007199254740992", ""},
+		{"-9007199254740992", ""},
+		{"9007199254740993", "9007199254740993"},
+		{"-9007199254740993", "-9007199254740993"},
+		{"18446744073709551615", "18446744073709551615"},
+		{"9007199254740993.0", "9007199254740993"},
+		{"9.007199254740993e15", "9007199254740993"},
+		{"9007199254740993.5", ""},
+		{"1e20", "100000000000000000000"},
+		{"1e-20", ""},
+		{"1e100000", ""},
+		{"123456789012345", ""},
+		{"1.5", ""},
+		{"0", ""},
+	}
+	for _, test := range tests {
+		exact, ok := exactIntegerForm([]byte(test.in))
+		if test.exact == "" {
+			if ok {
+				t.Errorf("%s: unexpected exact form %s", test.in, exact)
+			}
+			continue
+		}
+		if !ok || string(exact) != test.exact {
+			t.Errorf("%s: wanted %s got %s/%v", test.in, test.exact, exact, ok)
+		}
+	}
+}
+
+func TestExactIntegers(t *testing.T) {
+	_, err := New(WithExactIntegers(true), WithExactIntegers(true))
+	if err == nil {
+		t.Error("allowed 2 WithExactIntegers")
+	}
+
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{"big", `{"id": [9007199254740993]}`},
+		{"bigger", `{"id": [18446744073709551615]}`},
+		{"small", `{"id": [42, 9007199254740992]}`},
+		{"str", `{"id": ["9007199254740993"]}`},
+	}
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed, BuiltLazily} {
+		q, _ := New(WithExactIntegers(true), WithNumericStrings(true))
+		_ = q.SetMatcherBuildMode(mode)
+		for _, p := range patterns {
+			if err := q.AddPattern(p.name, p.pattern); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		events := []struct {
+			event   string
+			matches []string
+		}{
+			{`{"id": 9007199254740993}`, []string{"big"}},
+			{`{"id": 9.007199254740993e15}`, []string{"big"}},
+			{`{"id": 9007199254740992}`, []string{"small"}},
+			{`{"id": 9007199254740994}`, []string{}},
+			{`{"id": 18446744073709551615}`, []string{"bigger"}},
+			{`{"id": 18446744073709551614}`, []string{}},
+			{`{"id": 42.0}`, []string{"small"}},
+			{`{"id": "9007199254740993"}`, []string{"big", "str"}},
+		}
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if len(matches) != len(e.matches) {
+				t.Errorf("mode %d %s: wanted %v got %v", mode, e.event, e.matches, matches)
+				continue
+			}
+			for _, want := range e.matches {
+				if !containsX(matches, want) {
+					t.Errorf("mode %d %s: wanted %v got %v", mode, e.event, e.matches, matches)
+				}
+			}
+		}
+	}
+
+	// off by default
+	q, _ := New()
+	_ = q.AddPattern("big", `{"id": [9007199254740993]}`)
+	matches, _ := q.MatchesForEvent([]byte(`{"id": 9007199254740992}`))
+	if len(matches) != 1 {
+		t.Errorf("float64 comparison not the default: %v", matches)
+	}
+}
@@ -23,11 +23,19 @@ const (
 	monocaseType
 	wildcardType
 	regexpType
+	equalsFieldType
+	predicateType
+	existsFalseIgnoringNullType
+	sampleType
+	valueSetType
 )
 
 // typedVal represents the value of a field in a pattern, giving the value and the type of pattern.
 // - list is used to handle anything-but matches with multiple values.
 // - parsedRegexp only used for vType == regexpType
+// - for vType == predicateType, val is the name of the custom operator
+// - for vType == sampleType, val is the bucket range; see sample.go
+// - for vType == valueSetType, val is the name of the value set
 type typedVal struct {
 	vType        valType
 	val          string
@@ -42,20 +50,52 @@ type patternField struct {
 	vals []typedVal
 }
 
+// patternOptions carries the instance-wide settings, established with Option calls to New, which affect the
+// way that Patterns are compiled. The zero value gives Quamina's default behavior.
+// foldFieldNames arranges for member names to be compared case-insensitively; see foldCase.
+// nullAsMissing arranges for exists:true not to match null values, and exists:false to match fields that
+// only have null values.
+// numericStrings arranges for numeric patterns to match strings which contain numbers.
+// exactIntegers arranges for integers too large to be represented exactly as float64 to be matched exactly;
+// see exactIntegerForm.
+// quantifierLimit, if not zero, replaces regexpDefaultQuantifierLimit.
+type patternOptions struct {
+	foldFieldNames  bool
+	nullAsMissing   bool
+	numericStrings  bool
+	exactIntegers   bool
+	quantifierLimit int
+}
+
+func (opts *patternOptions) regexpQuantifierLimit() int {
+	if opts.quantifierLimit == 0 {
+		return regexpDefaultQuantifierLimit
+	}
+	return opts.quantifierLimit
+}
+
 // patternBuild tracks the progress of patternFromJSON through a pattern-compilation project.
 type patternBuild struct {
 	jd      *json.Decoder
 	path    []string
 	results []*patternField
+	opts    *patternOptions
 }
 
-// patternFromJSON compiles a JSON text provided in jsonBytes into a list of patternField structures.
+// patternFromJSON compiles a JSON text provided in jsonBytes into a list of patternField structures,
+// using the default patternOptions.
+func patternFromJSON(jsonBytes []byte) ([]*patternField, error) {
+	return patternFromJSONWithOptions(jsonBytes, &patternOptions{})
+}
+
+// patternFromJSONWithOptions does the work of patternFromJSON, subject to the provided patternOptions.
 // I love naked returns and I cannot lie
-func patternFromJSON(jsonBytes []byte) (fields []*patternField, err error) {
+func patternFromJSONWithOptions(jsonBytes []byte, opts *patternOptions) (fields []*patternField, err error) {
 	// we can't use json.Unmarshal because it round-trips numbers through float64 and %f, so they won't end up matching
 	// what the caller actually wrote in the patternField. json.Decoder is kind of slow due to excessive
 	// memory allocation, but I haven't got around to prematurely optimizing the patternFromJSON code path
 	var pb patternBuild
+	pb.opts = opts
 	pb.jd = json.NewDecoder(bytes.NewReader(jsonBytes))
 	pb.jd.UseNumber()
 
@@ -85,6 +125,9 @@ func patternFromJSON(jsonBytes []byte) (fields []*patternField, err error) {
 }
 
 func readPatternObject(pb *patternBuild) error {
+	// memberNames is only needed if the object turns out to be closed; see closed_object.go
+	var memberNames []string
+	closed := false
 	for {
 		t, err := pb.jd.Token()
 		if errors.Is(err, io.EOF) {
@@ -95,6 +138,22 @@ func readPatternObject(pb *patternBuild) error {
 
 		switch tt := t.(type) {
 		case string:
+			if tt == closedMemberName {
+				isClosed, isMarker, err := readClosedMember(pb)
+				if err != nil {
+					return err
+				}
+				if isMarker {
+					closed = isClosed
+				} else {
+					memberNames = append(memberNames, tt)
+				}
+				continue
+			}
+			if pb.opts.foldFieldNames {
+				tt = string(foldCase(nil, []byte(tt)))
+			}
+			memberNames = append(memberNames, tt)
 			pb.path = append(pb.path, tt)
 			err = readPatternMember(pb)
 			if err != nil {
@@ -104,6 +163,9 @@ func readPatternObject(pb *patternBuild) error {
 
 		case json.Delim:
 			// has to be '}' or the tokenizer would have thrown an error
+			if closed {
+				pb.results = append(pb.results, closedObjectField(pb, memberNames))
+			}
 			return nil
 		}
 	}
@@ -116,7 +178,11 @@ func readPatternMember(pb *patternBuild) error {
 	} else if err != nil {
 		return errors.New("pattern malformed: " + err.Error())
 	}
+	return readPatternValue(pb, t)
+}
 
+// readPatternValue reads the value of an object member, given its first token
+func readPatternValue(pb *patternBuild, t json.Token) error {
 	switch tt := t.(type) {
 	case json.Delim:
 		switch tt {
@@ -212,8 +278,30 @@ func readSpecialPattern(pb *patternBuild, valsIn []typedVal) (pathVals []typedVa
 	case "regexp":
 		containsExclusive = tt
 		pathVals, err = readRegexpSpecial(pb, pathVals)
+	case "regexp-std":
+		containsExclusive = tt
+		pathVals, err = readStdRegexpSpecial(pb, pathVals)
+	case "equals-field":
+		containsExclusive = tt
+		pathVals, err = readEqualsFieldSpecial(pb, pathVals)
+	case "is-null":
+		pathVals, err = readIsNullSpecial(pb, pathVals)
+	case "is-missing":
+		containsExclusive = tt
+		pathVals, err = readIsMissingSpecial(pb, pathVals)
+	case "sample":
+		pathVals, err = readSampleSpecial(pb, pathVals)
+	case "in-set":
+		pathVals, err = readInSetSpecial(pb, pathVals)
+	case "glob":
+		pathVals, err = readGlobSpecial(pb, pathVals)
 	default:
-		err = errors.New("unrecognized in special pattern: " + tt)
+		op, ok := lookupOperator(tt)
+		if !ok {
+			err = errors.New("unrecognized in special pattern: " + tt)
+			return
+		}
+		pathVals, err = readCustomOperatorSpecial(pb, tt, op, pathVals)
 	}
 	return
 }
@@ -249,9 +337,15 @@ func readExistsSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal
 	pathVals = valsIn
 	switch tt := t.(type) {
 	case bool:
-		if tt {
+		// if null is to be treated as missing, exists:true is the same as anything-but null
+		switch {
+		case tt && pb.opts.nullAsMissing:
+			pathVals = append(pathVals, typedVal{vType: anythingButType, list: [][]byte{nullBytes}})
+		case tt:
 			pathVals = append(pathVals, typedVal{vType: existsTrueType})
-		} else {
+		case pb.opts.nullAsMissing:
+			pathVals = append(pathVals, typedVal{vType: existsFalseIgnoringNullType})
+		default:
 			pathVals = append(pathVals, typedVal{vType: existsFalseType})
 		}
 	default:
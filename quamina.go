@@ -9,12 +9,22 @@ import (
 // not thread-safe in that it cannot safely be used simultaneously in multiple goroutines. To re-use a
 // Quamina instance concurrently in multiple goroutines, create copies using the Copy API.
 type Quamina struct {
-	flattener          Flattener
-	bufs               *nfaBuffers
-	matcher            matcher
-	mediaTypeSpecified bool
-	deletionSpecified  bool
-	buildMode          MatcherBuildMode
+	flattener           Flattener
+	bufs                *nfaBuffers
+	matcher             matcher
+	mediaTypeSpecified  bool
+	deletionSpecified   bool
+	deletionEnabled     bool
+	foldSpecified       bool
+	nullSpecified       bool
+	numStringSpecified  bool
+	exactIntSpecified   bool
+	quantLimitSpecified bool
+	// patternOpts accumulates the settings from Options which affect pattern compilation, and is used
+	// to construct the matcher once all the Options have been processed
+	patternOpts patternOptions
+	buildMode   MatcherBuildMode
+	xCodec      XCodec
 }
 
 // Option is an interface type used in Quamina's New API to pass in options. By convention, Option names
@@ -63,22 +73,123 @@ func WithFlattener(f Flattener) Option {
 }
 
 // WithPatternDeletion arranges, if the argument is true, that this Quamina instance will support
-// the DeletePatterns() method. This option call may not be provided more than once.
+// the DeletePatterns() method. The instance keeps an index from each X value to the parts of the matcher
+// which lead to it, so that deleting Patterns reclaims their memory. This option call may not be
+// provided more than once.
 func WithPatternDeletion(b bool) Option {
 	return func(q *Quamina) error {
 		if q.deletionSpecified {
 			return errors.New("pattern deletion already specified")
 		}
-		if b {
-			q.matcher = newPrunerMatcher(nil)
-		} else {
-			q.matcher = newCoreMatcher()
-		}
+		q.deletionEnabled = b
 		q.deletionSpecified = true
 		return nil
 	}
 }
 
+// WithCaseInsensitiveFieldNames arranges, if the argument is true, that member names in Patterns and Events
+// are compared without regard to case, so that a Pattern mentioning "userId" will match Events containing
+// "UserID" or "USERID". The comparison uses Unicode simple case folding. Only member names are affected;
+// values are compared exactly as usual.
+// This option call may not be provided more than once.
+func WithCaseInsensitiveFieldNames(b bool) Option {
+	return func(q *Quamina) error {
+		if q.foldSpecified {
+			return errors.New("case-insensitive field names already specified")
+		}
+		q.patternOpts.foldFieldNames = b
+		q.foldSpecified = true
+		return nil
+	}
+}
+
+// WithNullAsMissing arranges, if the argument is true, that fields whose value is null are treated as
+// missing by "exists" patterns, so that {"exists": true} does not match {"a": null}, while {"exists": false}
+// does. By default, null is a value like any other. This option does not affect the "is-null" and
+// "is-missing" patterns, nor patterns which match the literal null.
+// This option call may not be provided more than once.
+func WithNullAsMissing(b bool) Option {
+	return func(q *Quamina) error {
+		if q.nullSpecified {
+			return errors.New("null-as-missing already specified")
+		}
+		q.patternOpts.nullAsMissing = b
+		q.nullSpecified = true
+		return nil
+	}
+}
+
+// WithNumericStrings arranges, if the argument is true, that numeric values in patterns also match string
+// values in events which contain numbers, so that the pattern {"temperature": [21.5]} matches both
+// {"temperature": 21.5} and {"temperature": "21.5"}. The string must contain nothing but a number in JSON
+// syntax, with no leading or trailing spaces. String values in patterns still only match strings.
+// This option call may not be provided more than once.
+func WithNumericStrings(b bool) Option {
+	return func(q *Quamina) error {
+		if q.numStringSpecified {
+			return errors.New("numeric strings already specified")
+		}
+		q.patternOpts.numericStrings = b
+		q.numStringSpecified = true
+		return nil
+	}
+}
+
+// WithExactIntegers arranges, if the argument is true, that numeric patterns match integers exactly, even
+// those whose magnitude exceeds 2^53 and thus can't be represented exactly as float64 values. By default,
+// Quamina compares numbers as float64 values, so that for example the pattern {"id": [9007199254740993]}
+// matches {"id": 9007199254740992}. With this option, such integers are compared using their canonical
+// decimal form, so that 9007199254740993, 9007199254740993.0, and 9.007199254740993e15 are equal to each
+// other but not to any other number. This is useful for 64-bit identifiers.
+// This option call may not be provided more than once.
+func WithExactIntegers(b bool) Option {
+	return func(q *Quamina) error {
+		if q.exactIntSpecified {
+			return errors.New("exact integers already specified")
+		}
+		q.patternOpts.exactIntegers = b
+		q.exactIntSpecified = true
+		return nil
+	}
+}
+
+// WithRegexpQuantifierLimit sets the largest number which may appear in a range quantifier such as {4,128}
+// in the regexps of Patterns added to this instance; Patterns exceeding the limit are rejected. The default
+// is 100. A range quantifier is implemented by repeating the automaton for the quantified atom as many
+// times as the larger number, so raising the limit allows Patterns which make the matcher big and slow to
+// build, especially in BuiltForSpeed mode. The "maxRegexpQuantifier" and "largeRegexpQuantifiers" values reported by
+// GetMatcherStats help in keeping track of this.
+// This option call may not be provided more than once.
+func WithRegexpQuantifierLimit(limit int) Option {
+	return func(q *Quamina) error {
+		if q.quantLimitSpecified {
+			return errors.New("regexp quantifier limit already specified")
+		}
+		if limit < 1 {
+			return errors.New("regexp quantifier limit must be at least 1")
+		}
+		q.patternOpts.quantifierLimit = limit
+		q.quantLimitSpecified = true
+		return nil
+	}
+}
+
+// WithXCodec supplies the XCodec which MarshalBinary and UnmarshalBinary use to convert the X values of
+// Patterns to and from bytes. It is required if those APIs are to be used.
+// This option call may not be provided more than once.
+func WithXCodec(codec XCodec) Option {
+	return func(q *Quamina) error {
+		if codec == nil {
+			return errors.New("null XCodec")
+		}
+		if q.xCodec != nil {
+			return errors.New("XCodec already specified")
+		}
+		q.xCodec = codec
+		return nil
+	}
+}
+
 // WithPatternStorage supplies the Quamina instance with a LivePatternState
 // instance to be used to store the active patterns, i.e. those that have been
 // added with AddPattern but not deleted with DeletePattern. This option call
@@ -104,9 +215,12 @@ func New(opts ...Option) (*Quamina, error) {
 	if (!q.mediaTypeSpecified) && (q.flattener == nil) {
 		q.flattener = newJSONFlattener()
 	}
-	if !q.deletionSpecified {
-		q.matcher = newCoreMatcher()
+	patternOpts := q.patternOpts
+	matcher := newCoreMatcherWithOptions(&patternOpts)
+	if q.deletionEnabled {
+		matcher.deletion = newDeletionIndex()
 	}
+	q.matcher = matcher
 	q.bufs = newNfaBuffers()
 	q.buildMode = BuiltForComfort
 	return &q, nil
@@ -116,7 +230,7 @@ func New(opts ...Option) (*Quamina, error) {
 // goroutines.  Copy'ed instances share the same underlying data structures, so a pattern added to any instance
 // with AddPattern will be visible in all of them.
 func (q *Quamina) Copy() *Quamina {
-	return &Quamina{matcher: q.matcher, flattener: q.flattener.Copy(), bufs: newNfaBuffers()}
+	return &Quamina{matcher: q.matcher, flattener: q.flattener.Copy(), bufs: newNfaBuffers(), xCodec: q.xCodec}
 }
 
 // X is used in the AddPattern and MatchesForEvent APIs to identify the patterns that are added to
@@ -133,8 +247,37 @@ func (q *Quamina) AddPattern(x X, patternJSON string) error {
 	return q.matcher.addPattern(x, patternJSON, q.buildMode)
 }
 
-// DeletePatterns removes patterns identified by the x argument from the Quamina instance; the effect
-// is that return values from future calls to MatchesForEvent will not include this x value.
+// PatternSpec is a Pattern, and the X value which identifies it, to be added with AddPatterns
+type PatternSpec struct {
+	X       X
+	Pattern string
+}
+
+// AddPatterns adds many Patterns at once, with the same effect as calling AddPattern for each of them but
+// much more quickly, because the work of integrating new Patterns into the matcher is done once for all of
+// them rather than once for each, and on as many goroutines as GOMAXPROCS allows. Patterns whose first fields,
+// in lexical order, differ are built in parallel. If any of the Patterns is invalid, an error identifying it is
+// returned and none of them is added. Concurrent MatchesForEvent calls see either none of the Patterns or all
+// of them.
+func (q *Quamina) AddPatterns(specs []PatternSpec) error {
+	return q.matcher.addPatterns(specs, q.buildMode)
+}
+
+// AddExclusionPattern adds a pattern which suppresses matches of the patterns identified by the x argument.
+// That is to say, MatchesForEvent will not report x for an event which matches an exclusion pattern for x,
+// even if other patterns added with AddPattern for x match it. Any number of exclusion patterns may be
+// added for an x value. They have no effect on events which don't match any of x's patterns.
+// patternJSON is subject to the same rules, and AddExclusionPattern to the same concurrency
+// constraints, as for AddPattern.
+func (q *Quamina) AddExclusionPattern(x X, patternJSON string) error {
+	return q.matcher.addPattern(exclusionX{x}, patternJSON, q.buildMode)
+}
+
+// DeletePatterns removes patterns identified by the x argument, including exclusion patterns, from the Quamina
+// instance; the effect is that return values from future calls to MatchesForEvent will not include this x value.
+// The parts of the matcher which were there only for those patterns are removed; its cost is proportional to
+// their size rather than that of the whole matcher. Patterns which were frozen by Freeze or UnmarshalBinary
+// are filtered out of the results until the next call to Freeze drops them.
 func (q *Quamina) DeletePatterns(x X) error {
 	return q.matcher.deletePatterns(x)
 }
@@ -154,11 +297,13 @@ func (q *Quamina) MatchesForEvent(event []byte) ([]X, error) {
 // GetMatcherStats retrieves resource consumption data from a Quamina instance; its results depend only
 // on the AddPattern() calls that have been made previously. It runs in read-only mode without mutex
 // locking, so it should not be run in parallel with AddPattern() calls.
-// It returns a map to allow for the addition of consumption metrics in future. At the moment, the
-// only useful key is "bytes" and the corresponding value is the number of bytes consumed by the Quamina
+// It returns a map to allow for the addition of consumption metrics in future. The most useful key is
+// "bytes" and the corresponding value is the number of bytes consumed by the Quamina
 // matcher's data structures. The growth in this value correlates reasonably well with the slowdown
 // in AddPattern() and MatchesForEvent() performance in the case when the Patterns being added are
-// of the "wildcard" or "regexp" flavors.
+// of the "wildcard" or "regexp" flavors. Since large range quantifiers in regexps are a common cause of
+// such growth, "maxRegexpQuantifier" gives the largest number in any range quantifier, and
+// "largeRegexpQuantifiers" the number of Patterns whose range quantifiers exceed 100.
 func (q *Quamina) GetMatcherStats() map[string]float64 {
 	stats := q.matcher.getStats()
 	return map[string]float64{
@@ -166,7 +311,62 @@ func (q *Quamina) GetMatcherStats() map[string]float64 {
 		"bytes":     float64(stats.bytes),
 		"fanouts":   float64(stats.fanouts),
 		"maxFanout": float64(stats.maxFanout),
+
+		"maxRegexpQuantifier":    float64(stats.maxQuantifier),
+		"largeRegexpQuantifiers": float64(stats.largeQuantifiers),
+	}
+}
+
+// Compact reduces the size of the matcher by replacing each of the DFAs built in BuiltForSpeed mode with
+// the smallest equivalent DFA. The subset construction used to build DFAs tends to produce many redundant
+// states, particularly with large numbers of wildcard and regexp Patterns, so this can substantially reduce
+// the "bytes" value reported by GetMatcherStats. It also compresses large sets of exact-match string values
+// into a structure which shares their common endings as well as their beginnings. The cost of Compact is
+// proportional to the size of the whole matcher, which is why AddPattern doesn't do it automatically; it
+// makes sense to call it after adding a batch of Patterns. AddPattern calls wait while it works, but
+// MatchesForEvent calls can proceed.
+func (q *Quamina) Compact() {
+	q.matcher.compact()
+}
+
+// Freeze compiles the matcher into a read-only form in which the automaton is stored in a few large flat
+// arrays which refer to each other by index rather than by pointer. This greatly reduces the work the
+// garbage collector has to do for matchers with very large numbers of Patterns, which otherwise contain
+// millions of small objects that it scans repeatedly. Patterns added after Freeze go into an ordinary
+// matcher alongside the frozen one, and MatchesForEvent consults both; the next call to Freeze merges
+// them. So Freeze makes sense for a matcher which is built once, or in occasional large batches, and then
+// used for matching. It is best to call Compact first, as the frozen form can't be compacted. In
+// BuiltLazily mode, the frozen matcher goes on converting its NFAs to DFAs as events are matched. As with
+// Compact, AddPattern calls wait while it works, but MatchesForEvent calls can proceed.
+func (q *Quamina) Freeze() {
+	q.matcher.freeze(q.buildMode)
+}
+
+// MarshalBinary returns the compiled form of all the Patterns that have been added, which UnmarshalBinary
+// can restore into another Quamina instance much faster than the Patterns could be added again. The X
+// values of the Patterns are converted to bytes with the XCodec provided by WithXCodec. The result does not
+// include the build mode, the flattener, or the Options which don't affect how Patterns are compiled.
+// AddPattern calls wait while it works, but MatchesForEvent calls can proceed.
+func (q *Quamina) MarshalBinary() ([]byte, error) {
+	if q.xCodec == nil {
+		return nil, errors.New("MarshalBinary requires an XCodec; see WithXCodec")
+	}
+	return q.matcher.marshalBinary(q.xCodec, q.buildMode)
+}
+
+// UnmarshalBinary replaces the Patterns in the instance with those in data, which must have been produced
+// by MarshalBinary on an instance created with the same WithCaseInsensitiveFieldNames, WithNullAsMissing,
+// WithNumericStrings, WithExactIntegers, and WithPatternDeletion Options. The X values are restored with
+// the XCodec provided by WithXCodec. Any custom operators and value sets used by the Patterns must have
+// been registered. The restored matcher is frozen, as if by Freeze, so Patterns added afterward go
+// alongside it until the next call to Freeze.
+// UnmarshalBinary may be called while other goroutines use Copy'ed instances to match events; they will
+// see either all the old Patterns or all the new ones.
+func (q *Quamina) UnmarshalBinary(data []byte) error {
+	if q.xCodec == nil {
+		return errors.New("UnmarshalBinary requires an XCodec; see WithXCodec")
 	}
+	return q.matcher.unmarshalBinary(data, q.xCodec, q.buildMode)
 }
 
 // MatcherBuildMode enumerates the modes a Quamina instance can be in. The default is BuiltForComfort.
@@ -179,11 +379,17 @@ func (q *Quamina) GetMatcherStats() map[string]float64 {
 // in explosive growth of the size of the Matcher and the AddPattern latency.  This can be as bad as O(2**N)
 // in the number of Patterns. The use of the GetMatcherStats API is advised to investigate the effects of the
 // combination of this setting with an app's typical usage of AddPattern in production.
+// When an instance is in BuiltLazily mode, AddPattern builds NFAs as in BuiltForComfort mode, and
+// MatchesForEvent converts them to DFAs a step at a time as events are matched, caching the results. The
+// parts of the automaton that events actually exercise soon run at DFA speed, while the size of the cache,
+// which is shared by the whole matcher, is bounded, so the explosive growth that BuiltForSpeed risks can't
+// happen.
 type MatcherBuildMode int
 
 const (
 	BuiltForComfort MatcherBuildMode = iota
 	BuiltForSpeed
+	BuiltLazily
 )
 
 // SetMatcherBuildMode puts a Quamina instance into the provided mode
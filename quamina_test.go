@@ -64,9 +64,9 @@ func TestNewQOptions(t *testing.T) {
 	if err != nil {
 		t.Error("didn't take PatternDeletion(true")
 	}
-	_, ok = q.matcher.(*prunerMatcher)
-	if !ok {
-		t.Error("should be pruner")
+	cm, ok := q.matcher.(*coreMatcher)
+	if !ok || cm.deletion == nil {
+		t.Error("should support deletion")
 	}
 	q, err = New(WithPatternDeletion(false))
 	if err != nil {
@@ -97,9 +97,9 @@ func TestNewQOptions(t *testing.T) {
 	if err != nil {
 		t.Error("WithPatternDeletion failed: " + err.Error())
 	}
-	_, ok = q.matcher.(*prunerMatcher)
-	if !ok {
-		t.Error("not a pruner matcher")
+	cm, ok = q.matcher.(*coreMatcher)
+	if !ok || cm.deletion == nil {
+		t.Error("doesn't support deletion")
 	}
 	_, ok = q.flattener.(*flattenJSON)
 	if !ok {
@@ -189,3 +189,85 @@ func TestCityLots(t *testing.T) {
 		}
 	}
 }
+
+func TestCaseInsensitiveFieldNames(t *testing.T) {
+	_, err := New(WithCaseInsensitiveFieldNames(true), WithCaseInsensitiveFieldNames(true))
+	if err == nil {
+		t.Error("allowed 2 WithCaseInsensitiveFieldNames")
+	}
+
+	for _, deletion := range []bool{false, true} {
+		q, err := New(WithCaseInsensitiveFieldNames(true), WithPatternDeletion(deletion))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		err = q.AddPattern("p1", `{"UserID": ["x"], "Detail": {"Kind": [{"prefix": "ord"}]}}`)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		events := []string{
+			`{"userId": "x", "detail": {"kind": "order"}}`,
+			`{"USERID": "x", "DETAIL": {"KIND": "ordinal"}}`,
+			`{"UserID": "x", "Detail": {"Kind": "order"}}`,
+		}
+		for _, event := range events {
+			matches, err := q.MatchesForEvent([]byte(event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if len(matches) != 1 || matches[0] != "p1" {
+				t.Errorf("deletion %v: %s didn't match", deletion, event)
+			}
+		}
+		// values are still case-sensitive
+		matches, _ := q.MatchesForEvent([]byte(`{"userid": "X", "detail": {"kind": "order"}}`))
+		if len(matches) != 0 {
+			t.Error("value matched case-insensitively")
+		}
+	}
+
+	q, _ := New()
+	_ = q.AddPattern("p1", `{"UserID": ["x"]}`)
+	matches, _ := q.MatchesForEvent([]byte(`{"userId": "x"}`))
+	if len(matches) != 0 {
+		t.Error("default instance matched field names case-insensitively")
+	}
+}
+
+func TestRegexpQuantifierLimitOption(t *testing.T) {
+	_, err := New(WithRegexpQuantifierLimit(10), WithRegexpQuantifierLimit(10))
+	if err == nil {
+		t.Error("allowed 2 WithRegexpQuantifierLimit")
+	}
+	_, err = New(WithRegexpQuantifierLimit(0))
+	if err == nil {
+		t.Error("allowed limit 0")
+	}
+
+	q, _ := New()
+	if err = q.AddPattern("big", `{"a": [{"regexp": "[0-9]{4,1200}"}]}`); err == nil {
+		t.Error("default limit not enforced")
+	}
+
+	q, _ = New(WithRegexpQuantifierLimit(2000))
+	if err = q.AddPattern("big", `{"a": [{"regexp": "[0-9]{4,1200}"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = q.AddPattern("medium", `{"b": [{"regexp-std": "x{128}"}], "c": [{"regexp": "a{2,5}"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = q.AddPattern("small", `{"b": [{"regexp": "x{2,50}"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	stats := q.GetMatcherStats()
+	if stats["maxRegexpQuantifier"] != 1200 {
+		t.Errorf("maxRegexpQuantifier %f", stats["maxRegexpQuantifier"])
+	}
+	if stats["largeRegexpQuantifiers"] != 2 {
+		t.Errorf("largeRegexpQuantifiers %f", stats["largeRegexpQuantifiers"])
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"a": "12345"}`))
+	if len(matches) != 1 || matches[0] != "big" {
+		t.Errorf("wanted big, got %v", matches)
+	}
+}
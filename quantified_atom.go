@@ -9,7 +9,7 @@ type quantifiedAtom struct {
 	dotRunes        bool       // true if atom is "."
 	bigRuneRangeKey string     // for the huge character_properties RuneRanges
 	quantMin        int        // 0 means ? or *
-	quantMax        int        // the value regexpQuantifierMax means + or *, no max
+	quantMax        int        // the value regexpNoMax means +, *, or {n,}, no max
 	subtree         regexpRoot // if non-nil, ()-enclosed subtree here
 }
 
@@ -30,15 +30,15 @@ func (qa *quantifiedAtom) isQM() bool {
 }
 
 func (qa *quantifiedAtom) isPlus() bool {
-	return qa.quantMin == 1 && qa.quantMax == regexpQuantifierMax
+	return qa.quantMin == 1 && qa.quantMax == regexpNoMax
 }
 
 func (qa *quantifiedAtom) isStar() bool {
-	return qa.quantMin == 0 && qa.quantMax == regexpQuantifierMax
+	return qa.quantMin == 0 && qa.quantMax == regexpNoMax
 }
 
 func (qa *quantifiedAtom) hasMinMax() bool {
-	return qa.quantMax != regexpQuantifierMax && qa.quantMax != regexpMinimumOnly && qa.quantMax > 1
+	return qa.quantMax > 1
 }
 
 func (qa *quantifiedAtom) isNoOp() bool {
@@ -46,7 +46,7 @@ func (qa *quantifiedAtom) isNoOp() bool {
 }
 
 func (qa *quantifiedAtom) isMinimumOnly() bool {
-	return qa.quantMax == regexpMinimumOnly
+	return qa.quantMax == regexpNoMax
 }
 
 func (qa *quantifiedAtom) makeFA(nextStep *faState, pp printer) smallTable {
@@ -57,6 +57,9 @@ func (qa *quantifiedAtom) makeFA(nextStep *faState, pp printer) smallTable {
 		pp.labelTable(&table, "Dot")
 	case qa.getSubtree() != nil:
 		table = makeNFAFromBranches(qa.getSubtree(), nextStep, false, pp)
+	case len(qa.runes) == 0:
+		// a class with nothing in it, e.g. [a-[a-z]], matches nothing
+		table = newSmallTable()
 	case qa.runeRangeCache() != "":
 		table = makeAndCacheRuneRangeFA(qa.runes, nextStep, qa.runeRangeCache(), pp)
 	default:
@@ -99,3 +99,96 @@ func testRegexpMatches(t *testing.T, tests []regexpSample) {
 		}
 	}
 }
+
+func TestStdRegexpPattern(t *testing.T) {
+	q, _ := New()
+	if err := q.AddPattern("std", `{"a": [{"regexp-std": "Stop\\.[\\[\\]]\\p{Lu}\\\\"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := q.AddPattern("tilde", `{"a": [{"regexp": "Stop~.[~[~]]~p{Lu}\\"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, err := q.MatchesForEvent([]byte(`{"a": "Stop.[X\\"}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(matches) != 2 {
+		t.Errorf("wanted both, got %v", matches)
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"a": "Stopx[X\\"}`))
+	if len(matches) != 0 {
+		t.Errorf("wanted none, got %v", matches)
+	}
+	if err := q.AddPattern("bad", `{"a": [{"regexp-std": "Stop~"}], "b": [{"regexp-std": 3}]}`); err == nil {
+		t.Error("accepted non-string regexp-std")
+	}
+}
+
+func TestMultiCharEscapes(t *testing.T) {
+	tests := []regexpSample{
+		{regex: "~d+", matches: []string{"0", "123", "٣", "१२"}, nomatches: []string{"", "a", "1a", "½"}},
+		{regex: "~D", matches: []string{"a", "½", "-"}, nomatches: []string{"1", "٣"}},
+		{regex: "a~sb", matches: []string{"a b", "a\\tb", "a\\nb", "a\\rb"}, nomatches: []string{"ab", "a  b", "a b"}},
+		{regex: "~S+", matches: []string{"abc", "x y"}, nomatches: []string{"a b", ""}},
+		{regex: "~i~c*", matches: []string{"foo", "_x", ":a.b-c", "é9", "a·"}, nomatches: []string{"9a", "-a", ".a", "a b"}},
+		{regex: "~I~C", matches: []string{"9 ", "- "}, nomatches: []string{"a ", "9a", "--"}},
+		{regex: "~w+", matches: []string{"abc", "a1", "日本", "+"}, nomatches: []string{"a b", "a-b", "a.b", "a_b"}},
+		{regex: "~W", matches: []string{" ", "-", "_", "."}, nomatches: []string{"a", "1", "+"}},
+		{regex: "[~d~s]+", matches: []string{"1 2", "12"}, nomatches: []string{"1a"}},
+		{regex: "[^~d]", matches: []string{"a", " "}, nomatches: []string{"1"}},
+		{regex: "[a~d]x", matches: []string{"ax", "5x"}, nomatches: []string{"bx"}},
+	}
+	testRegexpMatches(t, tests)
+}
+
+func TestClassSubtraction(t *testing.T) {
+	tests := []regexpSample{
+		{regex: "[a-z-[aeiou]]+", matches: []string{"xyz", "bcd"}, nomatches: []string{"abc", "A", ""}},
+		{regex: "[^a-z-[0-9]]", matches: []string{"A", "-"}, nomatches: []string{"a", "5"}},
+		{regex: "[~w-[~d]]+", matches: []string{"abc", "日本"}, nomatches: []string{"a1", "٣"}},
+		{regex: "[~p{Ll}-[a-d]]+", matches: []string{"xyzé"}, nomatches: []string{"abc", "X"}},
+		{regex: "[a-z-[b-y-[c]]]+", matches: []string{"acz"}, nomatches: []string{"b", "d"}},
+		{regex: "[abc~--[b]]+", matches: []string{"a-c"}, nomatches: []string{"b"}},
+		{regex: "x[a-[a-f]]?y", matches: []string{"xy"}, nomatches: []string{"xay"}},
+	}
+	testRegexpMatches(t, tests)
+
+	bads := []string{"[a-z-[]]", "[a-z-[a]", "[a-z-[a]b]", "[[a]-[b]]", "[^-[a]]"}
+	for _, bad := range bads {
+		_, err := readRegexp(bad)
+		if err == nil {
+			t.Errorf("accepted %s", bad)
+		}
+	}
+}
+
+func TestScriptsAndBlocks(t *testing.T) {
+	tests := []regexpSample{
+		{regex: "~p{Script=Han}+", matches: []string{"中文", "漢字"}, nomatches: []string{"ひらがな", "abc", "中a"}},
+		{regex: "~P{Script=Latin}+", matches: []string{"Ωμέγα", "123"}, nomatches: []string{"abc", "αb"}},
+		{regex: "[~p{Script=Greek}~p{Script=Cyrillic}]+", matches: []string{"αβγ", "жук", "αж"}, nomatches: []string{"a", "中"}},
+		{regex: "[~p{Script=Latin}-[a-z]]+", matches: []string{"ABC", "é"}, nomatches: []string{"abc"}},
+		{regex: "~p{Script=Old_Italic}", matches: []string{"𐌀"}, nomatches: []string{"a"}},
+		{regex: "~p{IsBasicLatin}+", matches: []string{"abc~"}, nomatches: []string{"é"}},
+		{regex: "~P{IsGreekandCoptic}", matches: []string{"a"}, nomatches: []string{"α"}},
+		{regex: "[~p{IsHiragana}~p{IsKatakana}]+", matches: []string{"ひらカタ"}, nomatches: []string{"漢"}},
+
+		// the XSD 1.0 names of blocks which Unicode has renamed
+		{regex: "~p{IsGreek}+", matches: []string{"αβγ", "Ωϗ"}, nomatches: []string{"a", "ἀ", "αb"}},
+		{regex: "~P{IsGreek}", matches: []string{"a"}, nomatches: []string{"α"}},
+		{regex: "~p{IsCombiningMarksforSymbols}", matches: []string{"\u20d7"}, nomatches: []string{"\u0301"}},
+		{regex: "~p{IsPrivateUse}+", matches: []string{"\ue000\U000f0000\U0010fffd"}, nomatches: []string{"a", "\uf900"}},
+	}
+	testRegexpMatches(t, tests)
+
+	bads := []string{
+		"~p{Script=Klingon}", "~p{Script=}", "~p{script=Han}", "~p{IsKlingon}", "~p{Is}", "~p{Lx}", "~p{Lul}",
+		"~p{}", "~p{L", "~p{IsBasicLatin",
+	}
+	for _, bad := range bads {
+		_, err := readRegexp(bad)
+		if err == nil {
+			t.Errorf("accepted %s", bad)
+		}
+	}
+}
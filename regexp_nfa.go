@@ -80,21 +80,16 @@ func faFromQuantifiedAtom(branch regexpBranch, index int, finalStep *faState, pp
 	switch {
 	case atom.isPlus():
 		// the + construction requires a loopback state in front of the state table
+		// which loops back to the newly created state. If the atom has alternatives, merging them may need to
+		// splice the loopback, so its epsilons have to be in place before the atom's FA is built
+		state = &faState{}
 		plusLoopback := &faState{table: newSmallTable()}
 		pp.labelTable(&plusLoopback.table, "PlusLoopback")
-		state = &faState{table: atom.makeFA(plusLoopback, pp)}
-
-		// for the + case, need to loop back to the newly created state
 		plusLoopback.table.epsilons = []*faState{nextState, state}
+		state.table = atom.makeFA(plusLoopback, pp)
 
 	case atom.isStar():
-		// the * construction requires that the generated FA points back to itself
-		state = &faState{}
-		state.table = atom.makeFA(state, pp)
-
-		// the * construct requires an epsilon transition forward, possibly
-		// passing over a multi-step sequence
-		state.table.epsilons = append(state.table.epsilons, nextState)
+		state = makeStarFA(atom, nextState, pp)
 
 	case atom.hasMinMax():
 		shellTable := atom.makeFA(PlaceholderState, pp)
@@ -126,9 +121,7 @@ func faFromQuantifiedAtom(branch regexpBranch, index int, finalStep *faState, pp
 	case atom.isMinimumOnly():
 		// {0,} has no mandatory steps, so it means the same thing as *
 		if atom.quantMin == 0 {
-			state = &faState{}
-			state.table = atom.makeFA(state, pp)
-			state.table.epsilons = append(state.table.epsilons, nextState)
+			state = makeStarFA(atom, nextState, pp)
 			break
 		}
 
@@ -242,3 +235,22 @@ func makeDotFA(dest *faState) smallTable {
 		},
 	}
 }
+
+// makeStarFA builds the * construction, in which the generated FA points back to itself and there is an epsilon
+// transition forward, possibly passing over a multi-step sequence. If the atom has alternatives, merging them
+// may need to splice the loopback, at which point the state being built has no table yet, so in that case the
+// loopback goes via an epsilon-only state.
+func makeStarFA(atom *quantifiedAtom, nextState *faState, pp printer) *faState {
+	state := &faState{}
+	if len(atom.getSubtree()) < 2 {
+		state.table = atom.makeFA(state, pp)
+		state.table.epsilons = append(state.table.epsilons, nextState)
+		return state
+	}
+	loopback := &faState{table: newSmallTable()}
+	pp.labelTable(&loopback.table, "StarLoopback")
+	loopback.table.epsilons = []*faState{state}
+	state.table = atom.makeFA(loopback, pp)
+	state.table.epsilons = append(state.table.epsilons, nextState)
+	return state
+}
@@ -124,6 +124,35 @@ func TestRegexpPlus(t *testing.T) {
 	}
 }
 
+// when a quantified group has alternatives that share a prefix, merging them has to splice the loopback
+func TestQuantifiedAlternatives(t *testing.T) {
+	tests := []struct {
+		re    string
+		match string
+		want  bool
+	}{
+		{"(a|ab)*", "aab", true},
+		{"(a|ab)*", "", true},
+		{"(a|ab)*", "aabb", false},
+		{"(ab|a)*c", "abaabc", true},
+		{"(ab|a){0,}c", "aabc", true},
+		{"(a|ab)+", "aab", true},
+		{"(a|ab)+", "abab", true},
+		{"(a|ab)+", "", false},
+		{"(a|ab)+", "b", false},
+	}
+	trans := []*fieldMatcher{}
+	bufs := newNfaBuffers()
+	for _, test := range tests {
+		fa := faFromRegexp(t, test.re, sharedNullPrinter)
+		epsilonClosure(fa)
+		res := testTraverseNFA(fa, []byte(`"`+test.match+`"`), trans, bufs)
+		if (len(res) == 1) != test.want {
+			t.Errorf("%s on %q: wanted %t", test.re, test.match, test.want)
+		}
+	}
+}
+
 func TestExploreUTF8Form(t *testing.T) {
 	bads := [][]byte{
 		{0xc0, 0x80},             //0
@@ -13,6 +13,9 @@ type regexpParse struct {
 	nesting   []regexpRoot
 	features  *regexpFeatureChecker
 	tree      regexpRoot
+	escape    rune
+
+	quantifierLimit int
 }
 
 func (p *regexpParse) nest() {
@@ -39,9 +42,20 @@ func newRxParseState(t []byte) *regexpParse {
 		bytes:    t,
 		features: defaultRegexpFeatureChecker(),
 		tree:     regexpRoot{},
+		escape:   Escape,
+
+		quantifierLimit: regexpDefaultQuantifierLimit,
 	}
 }
 
+// newStdRxParseState is like newRxParseState, but for regexps that use the conventional "\" escape
+// character rather than "~"
+func newStdRxParseState(t []byte) *regexpParse {
+	parse := newRxParseState(t)
+	parse.escape = stdEscape
+	return parse
+}
+
 func (p *regexpParse) nextRune() (rune, error) {
 	if p.index >= len(p.bytes) {
 		return 0, errRegexpEOF
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -35,6 +36,8 @@ const (
 	rxfClass           regexpFeature = "[]-enclosed character-class matcher"
 	rxfNegatedClass    regexpFeature = "[^]-enclosed negative character-class matcher"
 	rxfOrBar           regexpFeature = "|-separated logical alternatives"
+	rxfMultiCharEscape regexpFeature = "~-prefixed multi-character escape such as ~d"
+	rxfSubtraction     regexpFeature = "-[]-enclosed character-class subtraction"
 )
 
 type regexpFeatureChecker struct {
@@ -55,14 +58,25 @@ var implementedRegexpFeatures = map[regexpFeature]bool{
 	rxfProperty:        true,
 	rxfNegatedProperty: true,
 	rxfRange:           true,
+	rxfMultiCharEscape: true,
+	rxfSubtraction:     true,
 }
 
-// distinguished values to mark idioms like * and {}
-const regexpQuantifierMax = 100 // TODO: make this into an option
-const regexpMinimumOnly = 200
+// regexpNoMax is the quantMax value of quantifiers with no upper bound: *, +, and {n,}
+const regexpNoMax = -1
 
+// regexpDefaultQuantifierLimit is the largest number which may appear in a {} range quantifier, unless a
+// different limit is established with WithRegexpQuantifierLimit. Since a range quantifier is implemented by
+// repeating its atom's automaton as many times as the larger number, large values are costly.
+const regexpDefaultQuantifierLimit = 100
+
+// Escape is the escape character in Quamina regexps.
 const Escape rune = '~'
 
+// stdEscape is the conventional escape character, which is used in "regexp-std" patterns in place of Escape;
+// the same regexp written with either produces the same regexpRoot.
+const stdEscape rune = '\\'
+
 func runeToUTF8(r rune) ([]byte, error) {
 	rl := utf8.RuneLen(r)
 	if rl == -1 {
@@ -74,6 +88,16 @@ func runeToUTF8(r rune) ([]byte, error) {
 }
 
 func readRegexpSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	return readRegexpSpecialWith(pb, valsIn, "regexp", Escape)
+}
+
+// readStdRegexpSpecial reads the value of a "regexp-std" pattern, which is like "regexp" except that
+// it uses "\" rather than "~" as the escape character
+func readStdRegexpSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	return readRegexpSpecialWith(pb, valsIn, "regexp-std", stdEscape)
+}
+
+func readRegexpSpecialWith(pb *patternBuild, valsIn []typedVal, name string, escape rune) (pathVals []typedVal, err error) {
 	pathVals = valsIn
 	t, err := pb.jd.Token()
 	if err != nil {
@@ -82,14 +106,16 @@ func readRegexpSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal
 
 	regexpString, ok := t.(string)
 	if !ok {
-		err = errors.New("value for 'regexp' must be a string")
+		err = errors.New("value for '" + name + "' must be a string")
 		return
 	}
 	val := typedVal{
 		vType: regexpType,
 	}
-	var parse *regexpParse
-	parse, err = readRegexp(regexpString)
+	parse := newRxParseState([]byte(regexpString))
+	parse.escape = escape
+	parse.quantifierLimit = pb.opts.regexpQuantifierLimit()
+	_, err = readRegexpWithParse(parse)
 	if err != nil {
 		return
 	}
@@ -171,6 +197,11 @@ func readRegexp(re string) (*regexpParse, error) {
 	return readRegexpWithParse(newRxParseState([]byte(re)))
 }
 
+// readStdRegexp is like readRegexp, for regexps that use "\" as the escape character
+func readStdRegexp(re string) (*regexpParse, error) {
+	return readRegexpWithParse(newStdRxParseState([]byte(re)))
+}
+
 func readRegexpWithParse(parse *regexpParse) (*regexpParse, error) {
 	return parse, readBranches(parse)
 }
@@ -278,7 +309,7 @@ func readAtom(parse *regexpParse) (*quantifiedAtom, error) {
 		return nil, err
 	}
 	switch {
-	case isNormalChar(b):
+	case parse.isNormalChar(b):
 		qa.runes = RuneRange{RunePair{b, b}}
 		qa.quantMin, qa.quantMax = 1, 1
 		return &qa, nil
@@ -318,15 +349,15 @@ func readAtom(parse *regexpParse) (*quantifiedAtom, error) {
 		return &qa, nil
 	case b == ']':
 		return nil, fmt.Errorf("invalid ']' at %d", parse.lastOffset())
-	case b == Escape:
+	case b == parse.escape:
 		c, err := parse.nextRune()
 		if errors.Is(err, errRegexpEOF) {
-			return nil, errors.New("'~' at end of regular expression")
+			return nil, fmt.Errorf("'%c' at end of regular expression", parse.escape)
 		}
 		if err != nil {
 			return nil, err
 		}
-		escaped, ok := checkSingleCharEscape(c)
+		escaped, ok := parse.checkSingleCharEscape(c)
 		if ok {
 			qa.runes = RuneRange{RunePair{escaped, escaped}}
 			return &qa, nil
@@ -341,10 +372,12 @@ func readAtom(parse *regexpParse) (*quantifiedAtom, error) {
 			qa.runes, qa.bigRuneRangeKey, err = readProperty(parse, true)
 			return &qa, err
 		}
-		if bytes.ContainsRune([]byte("sSiIcCdDwW"), c) {
-			return nil, fmt.Errorf("multiple-character escape ~%c at %d", c, parse.lastOffset())
+		if runes, ok := multiCharEscapes[c]; ok {
+			parse.features.recordFeature(rxfMultiCharEscape)
+			qa.runes, qa.bigRuneRangeKey = runes, multiCharEscapeKey(c)
+			return &qa, nil
 		}
-		return nil, fmt.Errorf("invalid character '%c' after '~' at %d", c, parse.lastOffset())
+		return nil, fmt.Errorf("invalid character '%c' after '%c' at %d", c, parse.escape, parse.lastOffset())
 
 	case bytes.ContainsRune([]byte("?+*{"), b):
 		return nil, fmt.Errorf("invalid character '%c' at %d", b, parse.lastOffset())
@@ -355,7 +388,9 @@ func readAtom(parse *regexpParse) (*quantifiedAtom, error) {
 	}
 }
 
-// charClassExpr = "[" [ "^" ] ( "-" / CCE1 ) *CCE1 [ "-" ] "]"
+// charClassExpr = "[" [ "^" ] ( "-" / CCE1 ) *CCE1 [ "-" / ( "-" charClassExpr ) ] "]"
+// The second form is XSD's class subtraction, e.g. [a-z-[aeiou]], which matches the runes matched by the
+// first part of the class, after applying any "^", but not by the charClassExpr.
 
 func readCharClassExpr(parse *regexpParse) (RuneRange, error) {
 	// starting after the "["
@@ -371,17 +406,34 @@ func readCharClassExpr(parse *regexpParse) (RuneRange, error) {
 	if err != nil {
 		return nil, err
 	}
+	var subtrahend RuneRange
+	isSubtraction := false
 	trailingHyphen, _ := parse.bypassOptional('-') // already probed
 	if trailingHyphen {
-		rr = append(rr, RunePair{Lo: '-', Hi: '-'})
+		isSubtraction, _ = parse.bypassOptional('[')
+		if isSubtraction {
+			parse.features.recordFeature(rxfSubtraction)
+			subtrahend, err = readCharClassExpr(parse)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			rr = append(rr, RunePair{Lo: '-', Hi: '-'})
+		}
 	}
 	if err = parse.require(']'); err != nil {
+		if errors.Is(err, errRegexpEOF) {
+			err = errors.New("unterminated character class")
+		}
 		return nil, err
 	}
 	if isNegated {
 		parse.features.recordFeature(rxfNegatedClass)
 		rr = InvertRuneRange(rr)
 	}
+	if isSubtraction {
+		rr = subtractRuneRange(rr, subtrahend)
+	}
 	return rr, nil
 }
 
@@ -463,7 +515,7 @@ func readCCE1(parse *regexpParse, first bool) (RuneRange, error) {
 	var lo rune
 	if first && r == '-' {
 		return RuneRange{RunePair{'-', '-'}}, nil
-	} else if r == Escape {
+	} else if r == parse.escape {
 		r, _ = parse.nextRune() // have already probed
 		// maybe a good category, in which case we can't participate in range, so we're done
 		// or a malformed category
@@ -477,9 +529,13 @@ func readCCE1(parse *regexpParse, first bool) (RuneRange, error) {
 			rr, _, err := readProperty(parse, true)
 			return rr, err
 		}
-		escaped, ok := checkSingleCharEscape(r)
+		if rr, ok := multiCharEscapes[r]; ok {
+			parse.features.recordFeature(rxfMultiCharEscape)
+			return rr, nil
+		}
+		escaped, ok := parse.checkSingleCharEscape(r)
 		if !ok {
-			return nil, fmt.Errorf("invalid character '%c' after ~ at %d", r, parse.lastOffset())
+			return nil, fmt.Errorf("invalid character '%c' after %c at %d", r, parse.escape, parse.lastOffset())
 		}
 		lo = escaped
 		// we've seen a single-character escape
@@ -504,17 +560,23 @@ func readCCE1(parse *regexpParse, first bool) (RuneRange, error) {
 	if err != nil {
 		return nil, err
 	}
-	// might be end of range -] which is legal. Otherwise, has to be either a CChar or single-char escape
+	// might be end of range -] which is legal, or the -[ that starts a subtraction, which readCharClassExpr
+	// will deal with. Otherwise, has to be either a CChar or single-char escape
 	if r == ']' {
 		parse.backup1(r)
 		return RuneRange{RunePair{lo, lo}, {'-', '-'}}, nil
 	}
-	if r == Escape {
+	if r == '[' {
+		parse.backup1(r)
+		parse.backup1('-')
+		return RuneRange{RunePair{lo, lo}}, nil
+	}
+	if r == parse.escape {
 		r, err = parse.nextRune()
 		if err != nil {
 			return nil, err
 		}
-		escaped, ok := checkSingleCharEscape(r)
+		escaped, ok := parse.checkSingleCharEscape(r)
 		if !ok {
 			return nil, fmt.Errorf("invalid char '%c' after - at %d", r, parse.lastOffset())
 		}
@@ -532,9 +594,15 @@ func readCCE1(parse *regexpParse, first bool) (RuneRange, error) {
 	return RuneRange{RunePair{lo, r}}, nil
 }
 
+// multiCharEscapeKey gives the cachedFaShells key for a multi-character escape such as ~d; the "~"
+// distinguishes it from the keys used for properties
+func multiCharEscapeKey(c rune) string {
+	return string([]rune{Escape, c})
+}
+
 // catEsc = %s"\p{" charProp "}"
 // complEsc = %s"\P{" charProp "}"
-// charProp = IsCategory
+// charProp = IsCategory / IsBlock / IsScript
 // IsCategory = Letters / Marks / Numbers / Punctuation / Separators /
 // Symbols / Others
 // Letters = %s"L" [ ( %s"l" / %s"m" / %s"o" / %s"t" / %s"u" ) ]
@@ -545,6 +613,8 @@ func readCCE1(parse *regexpParse, first bool) (RuneRange, error) {
 // Separators = %s"Z" [ ( %s"l" / %s"p" / %s"s" ) ]
 // Symbols = %s"S" [ ( %s"c" / %s"k" / %s"m" / %s"o" ) ]
 // Others = %s"C" [ ( %s"c" / %s"f" / %s"n" / %s"o" ) ]
+// IsBlock = %s"Is" 1*( %x30-39 / %x41-5A / %x61-7A / "-" ) ; a name from Blocks.txt with the spaces removed
+// IsScript = %s"Script=" 1*( %x41-5A / %x61-7A / "_" ) ; a name from Scripts.txt
 
 var regexpPropDetails = map[rune]string{
 	'L': "ultmo",
@@ -561,32 +631,61 @@ func readProperty(parse *regexpParse, negated bool) (RuneRange, string, error) {
 	if err = parse.require('{'); err != nil {
 		return nil, "", err
 	}
-	propertyInitial, err := parse.nextRune()
+	name, err := readPropertyName(parse)
 	if err != nil {
 		return nil, "", err
 	}
-	propertyDetail, ok := regexpPropDetails[propertyInitial]
+	var runes RuneRange
+	var ok bool
+	switch {
+	case strings.HasPrefix(name, "Is"):
+		runes, ok = unicodeBlocks[strings.TrimPrefix(name, "Is")]
+	case strings.HasPrefix(name, "Script="):
+		runes, ok = unicodeScripts[strings.TrimPrefix(name, "Script=")]
+	default:
+		return readCategory(parse, name, negated)
+	}
 	if !ok {
-		return nil, "", fmt.Errorf("unknown property beginning with '%c' at %d", propertyInitial, parse.lastOffset())
+		return nil, "", fmt.Errorf("unknown property %s at %d", name, parse.lastOffset())
 	}
-	propDetailLetter, err := parse.nextRune()
-	if err != nil {
-		return nil, "", fmt.Errorf("error in property after {%c at %d", propertyInitial, parse.lastOffset())
+	// blocks and scripts are much less commonly used than categories, so their complements are computed
+	// on demand rather than being generated
+	if negated {
+		return InvertRuneRange(slices.Clone(runes)), "-" + name, nil
 	}
-	var property string
-	if propDetailLetter == '}' {
-		property = string(propertyInitial)
-	} else {
-		if !bytes.ContainsRune([]byte(propertyDetail), propDetailLetter) {
-			return nil, "", fmt.Errorf("unknown property ~P{%c%c} at %d", propertyInitial, propDetailLetter, parse.lastOffset())
+	return runes, name, nil
+}
+
+// readPropertyName reads up to and including the "}" that closes a property
+func readPropertyName(parse *regexpParse) (string, error) {
+	var name []rune
+	for {
+		r, err := parse.nextRune()
+		if err != nil {
+			return "", fmt.Errorf("unterminated property at %d", parse.lastOffset())
 		}
-		if err = parse.require('}'); err != nil {
-			return nil, "", err
+		if r == '}' {
+			return string(name), nil
 		}
-		property = string([]rune{propertyInitial, propDetailLetter})
+		name = append(name, r)
 	}
-	var runes RuneRange
+}
 
+// readCategory looks up the general category, e.g. Lu, named in a property
+func readCategory(parse *regexpParse, name string, negated bool) (RuneRange, string, error) {
+	letters := []rune(name)
+	if len(letters) == 0 {
+		return nil, "", fmt.Errorf("empty property at %d", parse.lastOffset())
+	}
+	propertyDetail, ok := regexpPropDetails[letters[0]]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown property beginning with '%c' at %d", letters[0], parse.lastOffset())
+	}
+	if len(letters) > 2 || (len(letters) == 2 && !strings.ContainsRune(propertyDetail, letters[1])) {
+		return nil, "", fmt.Errorf("unknown property %s at %d", name, parse.lastOffset())
+	}
+	var runes RuneRange
+	property := name
 	if negated {
 		runes, ok = negatedProperties[property]
 		property = "-" + property
@@ -614,11 +713,11 @@ func readQuantifier(parse *regexpParse, qa *quantifiedAtom) error {
 	switch b {
 	case '*':
 		parse.features.recordFeature(rxfStar)
-		qa.quantMin, qa.quantMax = 0, regexpQuantifierMax
+		qa.quantMin, qa.quantMax = 0, regexpNoMax
 		return nil
 	case '+':
 		parse.features.recordFeature(rxfPlus)
-		qa.quantMin, qa.quantMax = 1, regexpQuantifierMax
+		qa.quantMin, qa.quantMax = 1, regexpNoMax
 		return nil
 	case '?':
 		parse.features.recordFeature(rxfQM)
@@ -655,8 +754,11 @@ func readRangeQuantifier(parse *regexpParse, qa *quantifiedAtom) error {
 	if err != nil {
 		return err
 	}
+	if lo > int64(parse.quantifierLimit) {
+		return parse.quantifierLimitError(lo)
+	}
 	qa.quantMin = int(lo)
-	qa.quantMax = regexpQuantifierMax
+	qa.quantMax = regexpNoMax
 	switch b {
 	case '}':
 		// e.g. (whatever){2}
@@ -696,14 +798,16 @@ func readRangeQuantifier(parse *regexpParse, qa *quantifiedAtom) error {
 	if b != '}' {
 		return fmt.Errorf("invalid character %c at %d, expected '}'", b, parse.lastOffset())
 	}
-	var hi int64
 	if len(hiDigits) == 0 {
-		hi = regexpMinimumOnly
-	} else {
-		hi, err = strconv.ParseInt(string(hiDigits), 10, 32)
-		if err != nil {
-			return err
-		}
+		// e.g. (whatever){2,}
+		return nil
+	}
+	hi, err := strconv.ParseInt(string(hiDigits), 10, 32)
+	if err != nil {
+		return err
+	}
+	if hi > int64(parse.quantifierLimit) {
+		return parse.quantifierLimitError(hi)
 	}
 	if hi < lo {
 		return fmt.Errorf("invalid range quantifier, top must be greater than bottom")
@@ -712,6 +816,28 @@ func readRangeQuantifier(parse *regexpParse, qa *quantifiedAtom) error {
 	return nil
 }
 
+func (p *regexpParse) quantifierLimitError(n int64) error {
+	return fmt.Errorf("range quantifier %d at %d exceeds the limit of %d; see WithRegexpQuantifierLimit", n, p.lastOffset(), p.quantifierLimit)
+}
+
+// regexpMaxQuantifier returns the largest number appearing in a range quantifier in the tree, or 0 if there are none
+func regexpMaxQuantifier(tree regexpRoot) int {
+	largest := 0
+	for _, branch := range tree {
+		for _, qa := range branch {
+			largest = max(largest, qa.quantMin, qa.quantMax)
+			if qa.subtree != nil {
+				largest = max(largest, regexpMaxQuantifier(qa.subtree))
+			}
+		}
+	}
+	// the ?, *, and + quantifiers aren't range quantifiers
+	if largest == 1 {
+		largest = 0
+	}
+	return largest
+}
+
 // isNormalChar - not optimized, implemented line-by-line from the production for clarity
 func isNormalChar(c rune) bool {
 	if c <= 0x27 || c == ',' || c == '-' || (c >= 0x2F && c <= 0x3E) {
@@ -766,3 +892,24 @@ func checkSingleCharEscape(c rune) (rune, bool) {
 	}
 	return 0, false
 }
+
+// checkSingleCharEscape is like the checkSingleCharEscape function, except that it also allows the escape
+// character in use to be escaped
+func (p *regexpParse) checkSingleCharEscape(c rune) (rune, bool) {
+	if c == p.escape {
+		return c, true
+	}
+	return checkSingleCharEscape(c)
+}
+
+// isNormalChar is like the isNormalChar function, except that the escape character in use isn't normal and
+// the other one is; so "~" is a normal character in a "regexp-std" pattern.
+func (p *regexpParse) isNormalChar(c rune) bool {
+	if c == p.escape {
+		return false
+	}
+	if c == Escape || c == stdEscape {
+		return true
+	}
+	return isNormalChar(c)
+}
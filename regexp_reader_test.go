@@ -2,6 +2,7 @@ package quamina
 
 import (
 	"cmp"
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
@@ -280,3 +281,106 @@ func TestRegexpReader(t *testing.T) {
 		t.Error("ap: " + err.Error())
 	}
 }
+
+// toStdRegexp rewrites a Quamina regexp using conventional "\" escaping
+func toStdRegexp(re string) string {
+	var out strings.Builder
+	runes := []rune(re)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case Escape:
+			if i+1 < len(runes) && runes[i+1] == Escape {
+				out.WriteRune(Escape)
+				i++
+			} else {
+				out.WriteRune(stdEscape)
+			}
+		case stdEscape:
+			out.WriteString(`\\`)
+		default:
+			out.WriteRune(runes[i])
+		}
+	}
+	return out.String()
+}
+
+func TestStdRegexpSameTree(t *testing.T) {
+	checked := 0
+	for _, sample := range regexpSamples {
+		parse, err := readRegexp(sample.regex)
+		std := toStdRegexp(sample.regex)
+		stdParse, stdErr := readStdRegexp(std)
+		if (err == nil) != (stdErr == nil) {
+			t.Errorf("%q: error %v, std %q error %v", sample.regex, err, std, stdErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(parse.tree, stdParse.tree) {
+			t.Errorf("%q and %q produce different trees", sample.regex, std)
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Error("no valid samples")
+	}
+
+	// "~" is an ordinary character in regexp-std
+	parse, err := readStdRegexp(`a~\~`)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(parse.tree[0]) != 3 || parse.tree[0][1].runes[0].Lo != '~' || parse.tree[0][2].runes[0].Lo != '~' {
+		t.Error("bad tree for a~\\~")
+	}
+	for _, bad := range []string{`a\`, `\q`, `[\q]`, `[a-\q]`} {
+		if _, err := readStdRegexp(bad); err == nil {
+			t.Errorf("accepted %q", bad)
+		}
+	}
+}
+
+func TestRegexpQuantifierLimit(t *testing.T) {
+	// these used to collide with the values that marked * and {n,}
+	for _, re := range []string{"a{2,100}", "a{2,200}", "[0-9]{4,128}", "a{1000}"} {
+		parse := newRxParseState([]byte(re))
+		parse.quantifierLimit = 1000
+		if _, err := readRegexpWithParse(parse); err != nil {
+			t.Errorf("%s: %s", re, err.Error())
+			continue
+		}
+		qa := parse.tree[0][0]
+		if !qa.hasMinMax() || qa.isStar() || qa.isPlus() || qa.isMinimumOnly() {
+			t.Errorf("%s: wrong quantifier %d-%d", re, qa.quantMin, qa.quantMax)
+		}
+	}
+	if _, err := readRegexp("a{2,100}"); err != nil {
+		t.Error(err.Error())
+	}
+	for _, re := range []string{"a{101}", "a{2,101}", "a{101,}"} {
+		if _, err := readRegexp(re); err == nil {
+			t.Errorf("accepted %s", re)
+		}
+	}
+
+	parse := newRxParseState([]byte("a{2,5000}"))
+	parse.quantifierLimit = 5000
+	if _, err := readRegexpWithParse(parse); err != nil {
+		t.Error(err.Error())
+	}
+	parse = newRxParseState([]byte("a{2,5}"))
+	parse.quantifierLimit = 4
+	if _, err := readRegexpWithParse(parse); err == nil {
+		t.Error("exceeded limit 4")
+	}
+
+	tree, _ := readRegexp("x(a{3}|(b{2,7})?)c+d*e{5,}")
+	if m := regexpMaxQuantifier(tree.tree); m != 7 {
+		t.Errorf("max quantifier %d", m)
+	}
+	tree, _ = readRegexp("a?b*c+")
+	if m := regexpMaxQuantifier(tree.tree); m != 0 {
+		t.Errorf("max quantifier %d", m)
+	}
+}
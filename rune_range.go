@@ -144,6 +144,33 @@ func InvertRuneRange(rr RuneRange) RuneRange {
 	return inverted
 }
 
+// subtractRuneRange returns the runes that are in rr but not in subtrahend
+func subtractRuneRange(rr RuneRange, subtrahend RuneRange) RuneRange {
+	rr = simplifyRuneRange(slices.Clone(rr))
+	subtrahend = simplifyRuneRange(slices.Clone(subtrahend))
+	var difference RuneRange
+	first := 0
+	for _, pair := range rr {
+		lo := pair.Lo
+		for first < len(subtrahend) && subtrahend[first].Hi < lo {
+			first++
+		}
+		for _, sub := range subtrahend[first:] {
+			if sub.Lo > pair.Hi {
+				break
+			}
+			if sub.Lo > lo {
+				difference = append(difference, RunePair{lo, sub.Lo - 1})
+			}
+			lo = sub.Hi + 1
+		}
+		if lo <= pair.Hi {
+			difference = append(difference, RunePair{lo, pair.Hi})
+		}
+	}
+	return difference
+}
+
 // only "next" or "node" is provided
 type skinnyRuneTreeEntry struct {
 	next *faState
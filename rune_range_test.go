@@ -2,6 +2,7 @@ package quamina
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 )
 
@@ -25,3 +26,27 @@ func TestSkinnyRuneTree(t *testing.T) {
 		t.Error("MISSED")
 	}
 }
+
+func TestSubtractRuneRange(t *testing.T) {
+	tests := []struct {
+		rr, subtrahend, want RuneRange
+	}{
+		{RuneRange{{'a', 'z'}}, RuneRange{{'e', 'e'}}, RuneRange{{'a', 'd'}, {'f', 'z'}}},
+		{RuneRange{{'a', 'z'}}, RuneRange{{'a', 'c'}, {'x', 'z'}}, RuneRange{{'d', 'w'}}},
+		{RuneRange{{'a', 'c'}, {'x', 'z'}}, RuneRange{{'b', 'y'}}, RuneRange{{'a', 'a'}, {'z', 'z'}}},
+		{RuneRange{{'a', 'f'}}, RuneRange{{'0', '9'}}, RuneRange{{'a', 'f'}}},
+		{RuneRange{{'a', 'a'}}, RuneRange{{'a', 'f'}}, nil},
+		{RuneRange{{'x', 'z'}, {'a', 'c'}}, RuneRange{{'b', 'b'}, {'a', 'a'}, {'y', 'y'}}, RuneRange{{'c', 'c'}, {'x', 'x'}, {'z', 'z'}}},
+		{RuneRange{{0, runeMax}}, RuneRange{{0, 'a'}, {'c', runeMax}}, RuneRange{{'b', 'b'}}},
+	}
+	for _, test := range tests {
+		rr := slices.Clone(test.rr)
+		got := subtractRuneRange(rr, test.subtrahend)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%v - %v: got %v want %v", test.rr, test.subtrahend, got, test.want)
+		}
+		if !slices.Equal(rr, test.rr) {
+			t.Errorf("%v was modified", test.rr)
+		}
+	}
+}
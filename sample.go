@@ -0,0 +1,107 @@
+package quamina
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// The "sample" pattern, e.g. {"sample": [0, 5]}, matches a stable subset of values, for example to route 5% of
+// customers to a canary. Each value is assigned to one of sampleBuckets buckets by hashing it, and the pattern
+// matches if the bucket number is in the range given by the two integers, the first inclusive and the second
+// exclusive. Since there are 100 buckets, the range can be thought of as percentages. The hash is the 32-bit
+// FNV-1a of the value as it appears in the Event, except that strings are hashed without their enclosing quote
+// marks, so the string "1234" and the number 1234 land in the same bucket. The same value always lands in the
+// same bucket, in all Quamina instances and versions.
+// Like predicates provided by custom operators, samples are not part of the valueMatcher's automaton but are
+// evaluated separately in valueMatcher.transitionOn.
+
+const sampleBuckets = 100
+
+// readSampleSpecial reads the bucket range of a "sample" pattern. The typedVal's val is the range in the form
+// "lo,hi", which is used both to identify the predicate and to reconstruct it in makeSamplePredicate.
+func readSampleSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	pathVals = valsIn
+	t, err := pb.jd.Token()
+	if err != nil {
+		return
+	}
+	delim, ok := t.(json.Delim)
+	if !ok || delim != '[' {
+		err = errors.New("value for 'sample' must be an array of two integers")
+		return
+	}
+	var bounds [2]int
+	for i := range bounds {
+		bounds[i], err = readSampleBound(pb)
+		if err != nil {
+			return
+		}
+	}
+	t, err = pb.jd.Token()
+	if err != nil {
+		return
+	}
+	delim, ok = t.(json.Delim)
+	if !ok || delim != ']' {
+		err = errors.New("value for 'sample' must be an array of two integers")
+		return
+	}
+	if bounds[0] >= bounds[1] {
+		err = fmt.Errorf("empty range in 'sample': [%d, %d]", bounds[0], bounds[1])
+		return
+	}
+	pathVals = append(pathVals, typedVal{vType: sampleType, val: fmt.Sprintf("%d,%d", bounds[0], bounds[1])})
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
+
+func readSampleBound(pb *patternBuild) (int, error) {
+	t, err := pb.jd.Token()
+	if err != nil {
+		return 0, err
+	}
+	n, ok := t.(json.Number)
+	if !ok {
+		return 0, errors.New("value for 'sample' must be an array of two integers")
+	}
+	bound, err := strconv.Atoi(string(n))
+	if err != nil || bound < 0 || bound > sampleBuckets {
+		return 0, fmt.Errorf("bounds in 'sample' must be integers between 0 and %d, not %s", sampleBuckets, n)
+	}
+	return bound, nil
+}
+
+// makeSamplePredicate returns a predicate which matches values whose bucket is in the range produced by
+// readSampleSpecial
+func makeSamplePredicate(bucketRange string) func([]byte) bool {
+	var lo, hi uint32
+	_, _ = fmt.Sscanf(bucketRange, "%d,%d", &lo, &hi)
+	return func(val []byte) bool {
+		bucket := sampleBucket(val)
+		return bucket >= lo && bucket < hi
+	}
+}
+
+// 32-bit FNV-1a parameters
+const (
+	fnvOffset32 uint32 = 2166136261
+	fnvPrime32  uint32 = 16777619
+)
+
+// sampleBucket assigns a value to a bucket using the 32-bit FNV-1a hash, computed inline because it is
+// called for every value tested against a sample pattern
+func sampleBucket(val []byte) uint32 {
+	if len(val) >= 2 && val[0] == '"' && val[len(val)-1] == '"' {
+		val = val[1 : len(val)-1]
+	}
+	h := fnvOffset32
+	for _, b := range val {
+		h ^= uint32(b)
+		h *= fnvPrime32
+	}
+	return h % sampleBuckets
+}
@@ -0,0 +1,108 @@
+package quamina
+
+import (
+	"fmt"
+	"hash/fnv"
+	"testing"
+)
+
+func TestSampleBucket(t *testing.T) {
+	// string and number forms of the same digits land in the same bucket
+	if sampleBucket([]byte(`"1234"`)) != sampleBucket([]byte(`1234`)) {
+		t.Error("quoted and unquoted values in different buckets")
+	}
+	// pin the hash, so that a change which would move values between buckets is noticed
+	if b := sampleBucket([]byte(`"customer-1"`)); b != 23 {
+		t.Errorf("customer-1 in bucket %d", b)
+	}
+	// the inline hash is FNV-1a, and doesn't allocate
+	for _, val := range []string{"", "a", "customer-1", "\u00e9t\u00e9", "9.007199254740993e15"} {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(val))
+		if b := sampleBucket([]byte(val)); b != h.Sum32()%sampleBuckets {
+			t.Errorf("%q: bucket %d, FNV-1a says %d", val, b, h.Sum32()%sampleBuckets)
+		}
+	}
+	val := []byte(`"customer-1"`)
+	if allocs := testing.AllocsPerRun(100, func() { sampleBucket(val) }); allocs != 0 {
+		t.Errorf("sampleBucket allocates %v times", allocs)
+	}
+}
+
+func TestSample(t *testing.T) {
+	q, _ := New()
+	if err := q.AddPattern("canary", `{"customerId": [{"sample": [0, 5]}], "region": ["us-east"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := q.AddPattern("rest", `{"customerId": [{"sample": [5, 100]}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := q.AddPattern("again", `{"customerId": [{"sample": [0, 5]}, "customer-1"]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	canaries := 0
+	for i := 0; i < 10000; i++ {
+		id := fmt.Sprintf("customer-%d", i)
+		inCanary := sampleBucket([]byte(id)) < 5
+		for _, region := range []string{"us-east", "eu-west"} {
+			event := fmt.Sprintf(`{"customerId": "%s", "region": "%s"}`, id, region)
+			matches, err := q.MatchesForEvent([]byte(event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if containsX(matches, "canary") != (inCanary && region == "us-east") {
+				t.Errorf("%s: canary wrong, matches %v", event, matches)
+			}
+			if containsX(matches, "rest") == inCanary {
+				t.Errorf("%s: rest wrong, matches %v", event, matches)
+			}
+			if containsX(matches, "again") != (inCanary || id == "customer-1") {
+				t.Errorf("%s: again wrong, matches %v", event, matches)
+			}
+		}
+		if inCanary {
+			canaries++
+		}
+	}
+	// should be about 500
+	if canaries < 400 || canaries > 600 {
+		t.Errorf("%d of 10000 in canary", canaries)
+	}
+
+	// stable across instances
+	q2, _ := New(WithPatternDeletion(true))
+	_ = q2.AddPattern("canary", `{"customerId": [{"sample": [0, 5]}]}`)
+	for i := 0; i < 1000; i++ {
+		event := fmt.Sprintf(`{"customerId": "customer-%d"}`, i)
+		m1, _ := q.MatchesForEvent([]byte(event))
+		m2, _ := q2.MatchesForEvent([]byte(event))
+		if containsX(m2, "canary") != containsX(m1, "again") && i != 1 {
+			t.Errorf("%s: instances disagree", event)
+		}
+	}
+}
+
+func TestSampleErrors(t *testing.T) {
+	bads := []string{
+		`{"a": [{"sample": 5}]}`,
+		`{"a": [{"sample": []}]}`,
+		`{"a": [{"sample": [5]}]}`,
+		`{"a": [{"sample": [0, 5, 10]}]}`,
+		`{"a": [{"sample": [5, 5]}]}`,
+		`{"a": [{"sample": [10, 5]}]}`,
+		`{"a": [{"sample": [0, 101]}]}`,
+		`{"a": [{"sample": [-1, 5]}]}`,
+		`{"a": [{"sample": [0.5, 5]}]}`,
+		`{"a": [{"sample": ["0", "5"]}]}`,
+	}
+	for _, bad := range bads {
+		_, err := patternFromJSON([]byte(bad))
+		if err == nil {
+			t.Errorf("accepted %s", bad)
+		}
+	}
+	if RegisterOperator("sample", Operator{Predicate: func([]byte) bool { return true }}) == nil {
+		t.Error("allowed registration of sample")
+	}
+}
@@ -23,8 +23,20 @@ type segmentsTree struct {
 	//  leaf "id" will be mapped to []byte("context\nuser\nid")
 	//  leaf "user", if it has non-node values, will be mapped to []byte("context\nuser")
 	fields map[string][]byte
+
+	// foldCase is set when member names are to be compared case-insensitively. In that case the paths added
+	// to the tree have already been case-folded, and the SegmentsTreeTracker methods fold the segment names
+	// they are asked about before looking them up.
+	foldCase bool
+
+	// closed lists the sets of member names which patterns have said are the only ones allowed at this level;
+	// see closed_object.go
+	closed []closedObject
 }
 
+// maxStackFoldedSegment is the size of the buffer used to case-fold segment names without allocating.
+const maxStackFoldedSegment = 64
+
 // newSegmentsIndex creates a segmentsTree node which is the root.
 // The paths argument is used for testing; it auto-adds those to the tree.
 func newSegmentsIndex(paths ...string) *segmentsTree {
@@ -35,6 +47,16 @@ func newSegmentsIndex(paths ...string) *segmentsTree {
 	return st
 }
 
+// newFoldingSegmentsIndex creates a root segmentsTree node which compares segment names case-insensitively.
+func newFoldingSegmentsIndex(paths ...string) *segmentsTree {
+	st := newSegmentsIndex()
+	st.foldCase = true
+	for _, path := range paths {
+		st.add(string(foldCase(nil, []byte(path))))
+	}
+	return st
+}
+
 // newSegmentsIndexNode initializes a segmentsTree node
 func newSegmentsIndexNode(root bool) *segmentsTree {
 	return &segmentsTree{
@@ -44,13 +66,21 @@ func newSegmentsIndexNode(root bool) *segmentsTree {
 	}
 }
 
+// key returns the form of the segment name that is used to look it up in the nodes and fields maps
+func (p *segmentsTree) key(buf []byte, segment []byte) []byte {
+	if !p.foldCase {
+		return segment
+	}
+	return foldCase(buf, segment)
+}
+
 func (p *segmentsTree) add(path string) {
 	segments := strings.Split(path, SegmentSeparator)
 
 	// If we have only one segment, it's a field on the root.
 	if len(segments) == 1 {
 		// It's a direct field.
-		p.fields[path] = []byte(path)
+		p.addSegment(path, []byte(path))
 		return
 	}
 
@@ -71,7 +101,9 @@ func (p *segmentsTree) add(path string) {
 func (p *segmentsTree) getOrCreate(name string) *segmentsTree {
 	_, ok := p.nodes[name]
 	if !ok {
-		p.nodes[name] = newSegmentsIndexNode(false)
+		node := newSegmentsIndexNode(false)
+		node.foldCase = p.foldCase
+		p.nodes[name] = node
 	}
 	return p.nodes[name]
 }
@@ -80,12 +112,16 @@ func (p *segmentsTree) addSegment(segment string, path []byte) {
 	_, ok := p.fields[segment]
 	if !ok {
 		p.fields[segment] = path
+		if len(segment) > 0 && segment[0] == closedObjectMarker {
+			p.addClosedObject(segment, path)
+		}
 	}
 }
 
 // Get implements SegmentsTreeTracker
 func (p *segmentsTree) Get(name []byte) (SegmentsTreeTracker, bool) {
-	n, ok := p.nodes[string(name)]
+	var buf [maxStackFoldedSegment]byte
+	n, ok := p.nodes[string(p.key(buf[:0], name))]
 	return n, ok
 }
 
@@ -100,6 +136,8 @@ func (p *segmentsTree) IsSegmentUsed(segment []byte) bool {
 	// "context" / "user" are nodes, while "id" is a field
 	// As a result a segment can be both node and field, we need to check
 	// in both maps.
+	var buf [maxStackFoldedSegment]byte
+	segment = p.key(buf[:0], segment)
 	_, isField := p.fields[string(segment)]
 	if isField {
 		return true
@@ -110,7 +148,8 @@ func (p *segmentsTree) IsSegmentUsed(segment []byte) bool {
 
 // PathForSegment implements SegmentsTreeTracker
 func (p *segmentsTree) PathForSegment(segment []byte) []byte {
-	return p.fields[string(segment)]
+	var buf [maxStackFoldedSegment]byte
+	return p.fields[string(p.key(buf[:0], segment))]
 }
 
 // NodesCount implements SegmentsTreeTracker
@@ -142,6 +181,8 @@ func (p *segmentsTree) String() string {
 // the Quamina automaton.
 func (p *segmentsTree) copy() *segmentsTree {
 	np := newSegmentsIndexNode(p.root)
+	np.foldCase = p.foldCase
+	np.closed = p.closed
 
 	// copy fields
 	for name, path := range p.fields {
@@ -77,3 +77,37 @@ func expectCounts(t *testing.T, tree SegmentsTreeTracker, fieldsCount, nodesCoun
 		t.Fatalf("Expected to have %v fields & %v nodes: %s", fieldsCount, nodesCount, tree.String())
 	}
 }
+
+func TestSegmentsTreeFoldCase(t *testing.T) {
+	tree := newFoldingSegmentsIndex("UserID", "Context\nUser\nId")
+
+	expectSegmentsToBeUsed(t, tree, "userid", "USERID", "UserId", "context", "CONTEXT")
+	if tree.IsSegmentUsed([]byte("user")) {
+		t.Error("user should not be used")
+	}
+	if string(tree.PathForSegment([]byte("USERID"))) != "userid" {
+		t.Errorf("PathForSegment returned %s", tree.PathForSegment([]byte("USERID")))
+	}
+
+	ctx, ok := tree.Get([]byte("cOnTeXt"))
+	if !ok {
+		t.Fatalf("Failed to fetch cOnTeXt: %s", tree.String())
+	}
+	user, ok := ctx.Get([]byte("USER"))
+	if !ok {
+		t.Fatalf("Failed to fetch USER: %s", ctx.String())
+	}
+	if string(user.PathForSegment([]byte("ID"))) != "context\nuser\nid" {
+		t.Errorf("PathForSegment returned %s", user.PathForSegment([]byte("ID")))
+	}
+
+	// copies should keep folding
+	cp := tree.copy()
+	expectSegmentsToBeUsed(t, cp, "USERID")
+
+	// non-folding trees are case-sensitive
+	plain := newSegmentsIndex("UserID")
+	if plain.IsSegmentUsed([]byte("userid")) {
+		t.Error("non-folding tree matched userid")
+	}
+}
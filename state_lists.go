@@ -19,6 +19,10 @@ type internEntry struct {
 // probably a more idiomatic and efficient way to do this.
 type stateLists struct {
 	entries map[string]internEntry
+	// nfaSetKeys records the keys of the NFA state sets interned since the last forgetNFASets call
+	nfaSetKeys []string
+	// fullSize is the number of entries left by the last conversion which didn't start from previous results
+	fullSize int
 	// Scratch space reused across intern() calls
 	sortBuf []*faState // reusable sorted buffer
 	keyBuf  []byte     // reusable key bytes buffer
@@ -36,6 +40,45 @@ func newStateLists() *stateLists {
 // which either has already been computed for the set or is created and empty, and
 // a boolean indicating whether the DFA state has already been computed or not.
 func (sl *stateLists) intern(list []*faState) ([]*faState, *faState, bool) {
+	sl.makeKey(list)
+
+	// string(sl.keyBuf) in a map lookup is optimized by the compiler to avoid allocation
+	if entry, exists := sl.entries[string(sl.keyBuf)]; exists {
+		return entry.states, entry.dfaState, true
+	}
+
+	// cache miss: allocate owned copies for the map
+	key := string(sl.keyBuf)
+	stored := make([]*faState, len(sl.sortBuf))
+	copy(stored, sl.sortBuf)
+
+	dfaState := &faState{table: newSmallTable()}
+	sl.entries[key] = internEntry{states: stored, dfaState: dfaState}
+	sl.nfaSetKeys = append(sl.nfaSetKeys, key)
+	return stored, dfaState, false
+}
+
+// addDFAState records that the set containing only dfaState, which has no epsilons, corresponds to dfaState
+// itself. This is what lets a later conversion, of an NFA built by merging new states into this DFA, stop
+// when it reaches a DFA state from this conversion.
+func (sl *stateLists) addDFAState(dfaState *faState) {
+	sl.makeKey([]*faState{dfaState})
+	sl.entries[string(sl.keyBuf)] = internEntry{states: []*faState{dfaState}, dfaState: dfaState}
+}
+
+// forgetNFASets discards the NFA state sets interned since the last call, leaving only the entries recorded
+// by addDFAState. Once a conversion is finished, its NFA states are garbage, so there's no point in keeping
+// them alive.
+func (sl *stateLists) forgetNFASets() {
+	for _, key := range sl.nfaSetKeys {
+		delete(sl.entries, key)
+	}
+	sl.nfaSetKeys = sl.nfaSetKeys[:0]
+}
+
+// makeKey leaves the deduplicated, sorted members of list in sl.sortBuf and the key identifying them as a
+// set in sl.keyBuf. Both are overwritten by the next call.
+func (sl *stateLists) makeKey(list []*faState) {
 	// Dedup by sorting then compacting adjacent duplicates. The set key is
 	// built from sorted pointers anyway, so sorting is not extra work; once
 	// sorted, duplicates are adjacent and Compact removes them in one linear
@@ -58,18 +101,4 @@ func (sl *stateLists) intern(list []*faState) ([]*faState, *faState, bool) {
 	for i, state := range sl.sortBuf {
 		binary.LittleEndian.PutUint64(sl.keyBuf[i*8:], uint64(uintptr(unsafe.Pointer(state))))
 	}
-
-	// string(sl.keyBuf) in a map lookup is optimized by the compiler to avoid allocation
-	if entry, exists := sl.entries[string(sl.keyBuf)]; exists {
-		return entry.states, entry.dfaState, true
-	}
-
-	// cache miss: allocate owned copies for the map
-	key := string(sl.keyBuf)
-	stored := make([]*faState, len(sl.sortBuf))
-	copy(stored, sl.sortBuf)
-
-	dfaState := &faState{table: newSmallTable()}
-	sl.entries[key] = internEntry{states: stored, dfaState: dfaState}
-	return stored, dfaState, false
 }
@@ -16,6 +16,9 @@ import (
 // will be null and the value being matched has to exactly equal the singletonMatch
 // field; if so, the singletonTransition is the return value. This is to avoid
 // having a long chain of smallTables each with only one entry.
+// Custom operators implemented with predicates (see custom_operator.go), samples (see sample.go), and value
+// sets (see value_set.go) aren't represented in the automaton, so they are kept in a list and each is tried in
+// addition to the singleton or automaton.
 // To allow for concurrent access between one thread running AddPattern and many
 // others running MatchesForEvent, the valueMatcher payload is stored in an
 // atomic.Pointer
@@ -28,6 +31,13 @@ type vmFields struct {
 	singletonTransition *fieldMatcher
 	hasNumbers          bool
 	isNondeterministic  bool
+	predicates          []predicateTransition
+	numericStrings      bool
+	exactIntegers       bool
+	lazyDFA             *lazyDFA
+	dfaStates           *stateLists
+	literals            *literalDAWG
+	hasNonLiterals      bool
 }
 
 func (m *valueMatcher) fields() *vmFields {
@@ -64,30 +74,76 @@ func (m *valueMatcher) transitionOn(eventField *Field, bufs *nfaBuffers) []*fiel
 		if bytes.Equal(vmFields.singletonMatch, val) {
 			transitions = append(transitions, vmFields.singletonTransition)
 		}
-		return transitions
 
 	case vmFields.start != nil:
-		// if there is a potential for a numeric match, try making a Q number from the event
-		if vmFields.hasNumbers && eventField.IsNumber {
-			qNum, err := qNumFromBytesBuf(val, &bufs.qNumBuf)
-			if err == nil {
-				if vmFields.isNondeterministic {
-					return traverseNFA(vmFields.start, qNum, transitions, bufs)
-				}
-				return traverseDFA(vmFields.start, qNum, transitions)
-			}
+		transitions = traverseValueFA(vmFields, eventField, transitions, bufs)
+
+	default:
+		// no FA, no singleton, nothing to do unless there are predicates
+	}
+
+	// string values compressed by Compact
+	if vmFields.literals != nil {
+		transitions = append(transitions, vmFields.literals.lookup(val)...)
+	}
+
+	for _, p := range vmFields.predicates {
+		if p.predicate(val) {
+			transitions = append(transitions, p.next)
+		}
+	}
+	return transitions
+}
+
+// numberForm returns the form of a number which is used in automata; the Q number, or if exact integers are
+// called for, the canonical decimal form of an integer that can't be represented exactly as a float64.
+func (fields *vmFields) numberForm(val []byte, bufs *nfaBuffers) ([]byte, error) {
+	return numberForm(fields.exactIntegers, val, &bufs.qNumBuf)
+}
+
+func numberForm(exactIntegers bool, val []byte, buf *[MaxBytesInEncoding]byte) ([]byte, error) {
+	if exactIntegers {
+		if exact, ok := exactIntegerForm(val); ok {
+			return exact, nil
+		}
+	}
+	return qNumFromBytesBuf(val, buf)
+}
+
+// traverseValueFA runs the field's value through the valueMatcher's automaton
+func traverseValueFA(vmFields *vmFields, eventField *Field, transitions []*fieldMatcher, bufs *nfaBuffers) []*fieldMatcher {
+	val := eventField.Val
+
+	// if there is a potential for a numeric match, try making a Q number from the event
+	if vmFields.hasNumbers && eventField.IsNumber {
+		qNum, err := vmFields.numberForm(val, bufs)
+		if err == nil {
+			return vmFields.traverse(qNum, transitions, bufs)
 		}
+	}
 
-		// if it doesn't work as a Q number for some reason, go ahead and compare the string values
-		if vmFields.isNondeterministic {
-			return traverseNFA(vmFields.start, val, transitions, bufs)
+	// if numeric patterns are to match strings that look like numbers, try the Q number form, then
+	// carry on to match the string value against string patterns
+	if vmFields.hasNumbers && vmFields.numericStrings && len(val) > 2 && val[0] == '"' && isJSONNumber(val[1:len(val)-1]) {
+		qNum, err := vmFields.numberForm(val[1:len(val)-1], bufs)
+		if err == nil {
+			transitions = vmFields.traverse(qNum, transitions, bufs)
 		}
-		return traverseDFA(vmFields.start, val, transitions)
+	}
+
+	// if it doesn't work as a Q number for some reason, go ahead and compare the string values
+	return vmFields.traverse(val, transitions, bufs)
+}
 
+// traverse picks the right way to run a value through the automaton
+func (fields *vmFields) traverse(val []byte, transitions []*fieldMatcher, bufs *nfaBuffers) []*fieldMatcher {
+	switch {
+	case fields.lazyDFA != nil:
+		return traverseLazyDFA(fields.lazyDFA, val, transitions, bufs)
+	case fields.isNondeterministic:
+		return traverseNFA(fields.start, val, transitions, bufs)
 	default:
-		// no FA, no singleton, nothing to do, this probably can't happen because a flattener
-		// shouldn't preserve a field that hasn't appeared in a pattern
-		return transitions
+		return traverseDFA(fields.start, val, transitions)
 	}
 }
 
@@ -95,6 +151,20 @@ func (m *valueMatcher) addTransition(val typedVal, printer printer, bufs *closur
 	valBytes := []byte(val.val)
 	fields := m.getFieldsForUpdate()
 
+	// predicates, samples, and value sets don't participate in the automaton
+	if val.vType == predicateType || val.vType == sampleType || val.vType == valueSetType {
+		nextField := fields.addPredicateTransition(val)
+		m.update(fields)
+		return nextField
+	}
+
+	// special case - this is a string match and it's among the values compressed by Compact
+	if fields.literals != nil && (val.vType == stringType || val.vType == literalType) {
+		if existing := fields.literals.lookup(valBytes); existing != nil {
+			return existing[0]
+		}
+	}
+
 	// special case - virgin state and this is a string match
 	if fields.start == nil && fields.singletonMatch == nil && (val.vType == stringType || val.vType == literalType) {
 		fields.singletonMatch = valBytes
@@ -111,8 +181,48 @@ func (m *valueMatcher) addTransition(val typedVal, printer printer, bufs *closur
 	}
 
 	// no dodges, we have to build an automaton to match this value
+	newFA, nextField := fields.makeValueFA(val, printer)
+
+	// there's already a table, thus an out-degree > 1
+	if fields.start != nil {
+		fields.start = mergeStartStates(fields.start, newFA, printer)
+		fields.prepareAutomaton(bufs, buildMode)
+
+		m.update(fields)
+		return nextField
+	}
+
+	// no start table, maybe singletons …
+	if fields.singletonMatch != nil {
+		// singleton is here, we don't match, so our outdegree becomes 2, so we have
+		// to build an automaton with two values in it.
+		singletonAutomaton, _ := makeStringFA(fields.singletonMatch, fields.singletonTransition, false)
+
+		// now table is ready for use, nuke singleton to signal threads to use it
+		fields.start = mergeStartStates(&faState{table: singletonAutomaton}, newFA, sharedNullPrinter)
+		fields.prepareAutomaton(bufs, buildMode)
+		fields.singletonMatch = nil
+		fields.singletonTransition = nil
+	} else {
+		// empty valueMatcher, no special cases, just jam in the new FA
+		fields.start = newFA
+		fields.prepareAutomaton(bufs, buildMode)
+	}
+	m.update(fields)
+	return nextField
+}
+
+// makeValueFA builds the automaton for a value which can't be handled by the singleton shortcut, and the
+// fieldMatcher it leads to, updating the flags which describe the valueMatcher's automaton
+func (fields *vmFields) makeValueFA(val typedVal, printer printer) (*faState, *fieldMatcher) {
+	valBytes := []byte(val.val)
 	var nextField *fieldMatcher
 
+	// only automata built entirely of string values can be compressed by Compact
+	if val.vType != stringType && val.vType != literalType {
+		fields.hasNonLiterals = true
+	}
+
 	// newFA holds the newly-built automaton. Most builders return a smallTable
 	// value to be wrapped in an faState; makeRegexpNFA and a few NFA builders
 	// return *faState directly. After this switch, newFAState is the start
@@ -123,7 +233,7 @@ func (m *valueMatcher) addTransition(val typedVal, printer printer, bufs *closur
 		t, fm := makeStringFA(valBytes, nil, false)
 		newFA, nextField = &faState{table: t}, fm
 	case numberType:
-		t, fm := makeStringFA(valBytes, nil, true)
+		t, fm := makeNumberFA(valBytes, fields.exactIntegers)
 		newFA, nextField = &faState{table: t}, fm
 		fields.hasNumbers = true
 	case anythingButType:
@@ -148,52 +258,75 @@ func (m *valueMatcher) addTransition(val typedVal, printer printer, bufs *closur
 	default:
 		panic("unknown value type")
 	}
+	return newFA, nextField
+}
 
-	// there's already a table, thus an out-degree > 1
-	if fields.start != nil {
-		fields.start = mergeStartStates(fields.start, newFA, printer)
-		if fields.isNondeterministic {
-			epsilonClosureInto(fields.start, bufs)
-			if buildMode == BuiltForSpeed {
-				fields.start = nfa2Dfa(fields.start)
-				fields.isNondeterministic = false
-			}
-		}
+// builtDeferred is used by addPatterns while it builds an automaton for a batch of patterns; prepareAutomaton
+// does nothing, and is called for each valueMatcher once the batch is complete.
+const builtDeferred MatcherBuildMode = -1
 
-		m.update(fields)
-		return nextField
+// prepareAutomaton readies a newly-built automaton for matching. Nondeterministic automata need their epsilon
+// closures computed, and then the build mode says whether they are converted to DFAs right away, lazily during
+// matching, or not at all.
+func (fields *vmFields) prepareAutomaton(bufs *closureBuffers, buildMode MatcherBuildMode) {
+	fields.lazyDFA = nil
+	if !fields.isNondeterministic || buildMode == builtDeferred {
+		return
 	}
+	epsilonClosureInto(fields.start, bufs)
+	switch buildMode {
+	case BuiltForSpeed:
+		fields.start, fields.dfaStates = incrementalNfa2Dfa(fields.start, fields.dfaStates)
+		fields.isNondeterministic = false
+	case BuiltLazily:
+		fields.lazyDFA = newLazyDFA(fields.start)
+	}
+}
 
-	// no start table, maybe singletons …
-	if fields.singletonMatch != nil {
-		// singleton is here, we don't match, so our outdegree becomes 2, so we have
-		// to build an automaton with two values in it.
-		singletonAutomaton, _ := makeStringFA(fields.singletonMatch, fields.singletonTransition, false)
-
-		// now table is ready for use, nuke singleton to signal threads to use it
-		fields.start = mergeStartStates(&faState{table: singletonAutomaton}, newFA, sharedNullPrinter)
-		if fields.isNondeterministic {
-			epsilonClosureInto(fields.start, bufs)
-			if buildMode == BuiltForSpeed {
-				fields.start = nfa2Dfa(fields.start)
-				fields.isNondeterministic = false
-			}
-		}
-		fields.singletonMatch = nil
-		fields.singletonTransition = nil
-	} else {
-		// empty valueMatcher, no special cases, just jam in the new FA
-		fields.start = newFA
-		if fields.isNondeterministic {
-			epsilonClosureInto(fields.start, bufs)
-			if buildMode == BuiltForSpeed {
-				fields.start = nfa2Dfa(fields.start)
-				fields.isNondeterministic = false
-			}
+// compact replaces a deterministic automaton with its minimal equivalent; see minimizeDFA
+func (m *valueMatcher) compact() {
+	current := m.fields()
+	if current.start == nil || current.isNondeterministic {
+		return
+	}
+	start, states := minimizeDFA(current.start)
+	if states == nil {
+		return
+	}
+	fields := m.getFieldsForUpdate()
+	fields.start = start
+	// the DFA states recorded for incremental conversion have all been replaced
+	if fields.dfaStates != nil {
+		fields.dfaStates = newStateLists()
+		for _, state := range states {
+			fields.dfaStates.addDFAState(state)
 		}
+		fields.dfaStates.fullSize = len(fields.dfaStates.entries)
 	}
 	m.update(fields)
-	return nextField
+}
+
+// matchNumericStrings arranges for numeric patterns in this valueMatcher to match string values
+// which contain numbers
+func (m *valueMatcher) matchNumericStrings() {
+	if m.fields().numericStrings {
+		return
+	}
+	fields := m.getFieldsForUpdate()
+	fields.numericStrings = true
+	m.update(fields)
+}
+
+// matchExactIntegers arranges for numeric patterns in this valueMatcher to represent integers which can't be
+// represented exactly as float64 values in their canonical decimal form rather than as Q numbers. It must be
+// called before such patterns are added.
+func (m *valueMatcher) matchExactIntegers() {
+	if m.fields().exactIntegers {
+		return
+	}
+	fields := m.getFieldsForUpdate()
+	fields.exactIntegers = true
+	m.update(fields)
 }
 
 func makePrefixFA(val []byte) (smallTable, *fieldMatcher) {
@@ -239,6 +372,20 @@ func makeStringFA(val []byte, useThisTransition *fieldMatcher, isNumber bool) (s
 	return stringFA, nextField
 }
 
+// makeNumberFA creates an automaton which matches a number in a pattern. If exactIntegers is set and the number
+// is an integer too large to be represented exactly as a float64, the automaton matches its canonical decimal
+// form rather than its Q number.
+func makeNumberFA(val []byte, exactIntegers bool) (smallTable, *fieldMatcher) {
+	if exactIntegers {
+		if exact, ok := exactIntegerForm(val); ok {
+			stringFA, nextField := makeStringFA(val, nil, false)
+			exactFA := makeOneStringFAStep(exact, 0, nextField)
+			return mergeFAs(&stringFA, &exactFA, sharedNullPrinter), nextField
+		}
+	}
+	return makeStringFA(val, nil, true)
+}
+
 // makeFAFragment makes the simplest possible byte-chain FA with its last transition being to the provided
 // endAt value. It is designed to help higher-level automaton builders.
 // suppose you need a few steps to match "cat". You call makeFAFragment and it'll make two *faState instances, one
@@ -0,0 +1,139 @@
+package quamina
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// A value set is a named list of strings, established with DefineValueSet, which Patterns can refer to with
+// {"in-set": "name"}. Each set is compiled once into a DFA which is shared by all the Patterns that refer to
+// it, in all Quamina instances. The DFA is not merged into the valueMatchers' automata; instead, like
+// the predicates of custom operators, it is consulted in valueMatcher.transitionOn. This means that
+// redefining a set changes the behavior of every Pattern that refers to it, without their being re-added.
+
+type valueSet struct {
+	start atomic.Pointer[faState]
+}
+
+var (
+	valueSets     = make(map[string]*valueSet)
+	valueSetsLock sync.RWMutex
+)
+
+// DefineValueSet establishes a named set of string values which Patterns in any Quamina instance may refer
+// to with the "in-set" Extended Pattern, for example {"sourceIP": [{"in-set": "blocked-ips"}]}. If the set
+// is already defined, its values are replaced, and this immediately affects the matching of all Patterns
+// that refer to it. A set must be defined before any Pattern that refers to it is added. The values must
+// be valid UTF-8. DefineValueSet is safe to call concurrently with matching.
+func DefineValueSet(name string, values []string) error {
+	if name == "" {
+		return errors.New("value set name must not be empty")
+	}
+	members := make([][]byte, 0, len(values))
+	for _, value := range values {
+		if !utf8.ValidString(value) {
+			return fmt.Errorf("value set %q: invalid UTF-8 in %q", name, value)
+		}
+		members = append(members, []byte(`"`+value+`"`))
+	}
+	start := makeValueSetFA(members)
+
+	valueSetsLock.Lock()
+	defer valueSetsLock.Unlock()
+	set, ok := valueSets[name]
+	if !ok {
+		set = &valueSet{}
+		valueSets[name] = set
+	}
+	set.start.Store(start)
+	return nil
+}
+
+func lookupValueSet(name string) (*valueSet, bool) {
+	valueSetsLock.RLock()
+	defer valueSetsLock.RUnlock()
+	set, ok := valueSets[name]
+	return set, ok
+}
+
+// contains checks whether the value is one of the members of the set
+func (s *valueSet) contains(val []byte) bool {
+	table := &s.start.Load().table
+	for _, utf8Byte := range val {
+		next := table.step(utf8Byte)
+		if next == nil {
+			return false
+		}
+		table = &next.table
+	}
+	return table.step(valueTerminator) != nil
+}
+
+// makeValueSetFA builds a DFA which matches any of the members. Rather than merging one string automaton per
+// member, which would take time quadratic in the size of the set, it sorts the members and builds a trie.
+func makeValueSetFA(members [][]byte) *faState {
+	if len(members) == 0 {
+		return &faState{table: newSmallTable()}
+	}
+	slices.SortFunc(members, func(a, b []byte) int { return slices.Compare(a, b) })
+	members = slices.CompactFunc(members, slices.Equal)
+	matched := &faState{table: newSmallTable()}
+	return &faState{table: makeValueSetStep(members, 0, matched)}
+}
+
+// makeValueSetStep makes the smallTable that steps on the byte at index in the members, which are sorted and share
+// the same first index bytes.
+func makeValueSetStep(members [][]byte, index int, matched *faState) smallTable {
+	var indices []byte
+	var steps []*faState
+	i := 0
+	// since the members are sorted, the one that ends here, if any, is first
+	endsHere := len(members[0]) == index
+	if endsHere {
+		i++
+	}
+	for i < len(members) {
+		utf8Byte := members[i][index]
+		j := i + 1
+		for j < len(members) && members[j][index] == utf8Byte {
+			j++
+		}
+		indices = append(indices, utf8Byte)
+		steps = append(steps, &faState{table: makeValueSetStep(members[i:j], index+1, matched)})
+		i = j
+	}
+	// valueTerminator is greater than any byte in UTF-8, so it goes last
+	if endsHere {
+		indices = append(indices, valueTerminator)
+		steps = append(steps, matched)
+	}
+	return makeSmallTable(nil, indices, steps)
+}
+
+// readInSetSpecial reads {"in-set": "name"}
+func readInSetSpecial(pb *patternBuild, valsIn []typedVal) (pathVals []typedVal, err error) {
+	t, err := pb.jd.Token()
+	if err != nil {
+		return
+	}
+	pathVals = valsIn
+
+	name, ok := t.(string)
+	if !ok {
+		err = errors.New("value for 'in-set' must be a string")
+		return
+	}
+	if _, ok = lookupValueSet(name); !ok {
+		err = fmt.Errorf("value set %q is not defined", name)
+		return
+	}
+	pathVals = append(pathVals, typedVal{vType: valueSetType, val: name})
+
+	// has to be } or tokenizer will throw error
+	_, err = pb.jd.Token()
+	return
+}
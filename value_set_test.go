@@ -0,0 +1,124 @@
+package quamina
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValueSetContains(t *testing.T) {
+	values := []string{"a", "ab", "abc", "b", "", "xyz", "ab", "héllo", "日本"}
+	if err := DefineValueSet("test-contains", values); err != nil {
+		t.Fatal(err.Error())
+	}
+	set, _ := lookupValueSet("test-contains")
+	for _, v := range values {
+		if !set.contains([]byte(`"` + v + `"`)) {
+			t.Errorf("missing %q", v)
+		}
+	}
+	for _, v := range []string{`"abcd"`, `"c"`, `"x"`, `"xy"`, `abc`, `"abc`, `"hello"`, `"日"`, `12`} {
+		if set.contains([]byte(v)) {
+			t.Errorf("contains %s", v)
+		}
+	}
+
+	if err := DefineValueSet("test-empty", nil); err != nil {
+		t.Fatal(err.Error())
+	}
+	set, _ = lookupValueSet("test-empty")
+	if set.contains([]byte(`""`)) || set.contains([]byte(`"a"`)) {
+		t.Error("empty set has members")
+	}
+}
+
+func TestValueSetPatterns(t *testing.T) {
+	var blocked []string
+	for i := 0; i < 100000; i++ {
+		blocked = append(blocked, fmt.Sprintf("10.%d.%d.%d", i>>16, (i>>8)&0xff, i&0xff))
+	}
+	if err := DefineValueSet("test-blocked-ips", blocked); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, mode := range []MatcherBuildMode{BuiltForComfort, BuiltForSpeed} {
+		q, _ := New(WithPatternDeletion(true))
+		_ = q.SetMatcherBuildMode(mode)
+		for i := 0; i < 100; i++ {
+			p := fmt.Sprintf(`{"service": ["svc%d"], "sourceIP": [{"in-set": "test-blocked-ips"}]}`, i)
+			if err := q.AddPattern(fmt.Sprintf("p%d", i), p); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+		if err := q.AddPattern("local", `{"sourceIP": ["127.0.0.1", {"in-set": "test-blocked-ips"}]}`); err != nil {
+			t.Fatal(err.Error())
+		}
+
+		events := []struct {
+			event   string
+			matches []string
+		}{
+			{`{"service": "svc3", "sourceIP": "10.1.134.159"}`, []string{"p3", "local"}},
+			{`{"service": "svc3", "sourceIP": "10.1.134.160"}`, []string{}},
+			{`{"service": "svc3", "sourceIP": "127.0.0.1"}`, []string{"local"}},
+			{`{"service": "svc300", "sourceIP": "10.0.0.0"}`, []string{"local"}},
+		}
+		for _, e := range events {
+			matches, err := q.MatchesForEvent([]byte(e.event))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if len(matches) != len(e.matches) {
+				t.Errorf("mode %d %s: wanted %v got %v", mode, e.event, e.matches, matches)
+				continue
+			}
+			for _, want := range e.matches {
+				if !containsX(matches, want) {
+					t.Errorf("mode %d %s: wanted %v got %v", mode, e.event, e.matches, matches)
+				}
+			}
+		}
+	}
+}
+
+func TestValueSetRedefinition(t *testing.T) {
+	if err := DefineValueSet("test-colors", []string{"red", "green"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	q, _ := New()
+	if err := q.AddPattern("color", `{"color": [{"in-set": "test-colors"}]}`); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ := q.MatchesForEvent([]byte(`{"color": "blue"}`))
+	if len(matches) != 0 {
+		t.Errorf("blue matched: %v", matches)
+	}
+	if err := DefineValueSet("test-colors", []string{"blue"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"color": "blue"}`))
+	if len(matches) != 1 {
+		t.Errorf("blue didn't match after redefinition: %v", matches)
+	}
+	matches, _ = q.MatchesForEvent([]byte(`{"color": "red"}`))
+	if len(matches) != 0 {
+		t.Errorf("red matched after redefinition: %v", matches)
+	}
+}
+
+func TestValueSetErrors(t *testing.T) {
+	if DefineValueSet("", []string{"a"}) == nil {
+		t.Error("allowed empty name")
+	}
+	if DefineValueSet("test-bad-utf8", []string{"a\xff"}) == nil {
+		t.Error("allowed invalid UTF-8")
+	}
+	bads := []string{
+		`{"a": [{"in-set": "test-undefined"}]}`,
+		`{"a": [{"in-set": 3}]}`,
+		`{"a": [{"in-set": ["test-colors"]}]}`,
+	}
+	for _, bad := range bads {
+		if _, err := patternFromJSON([]byte(bad)); err == nil {
+			t.Errorf("accepted %s", bad)
+		}
+	}
+}